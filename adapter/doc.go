@@ -0,0 +1,22 @@
+/*
+Package adapter implements the NFTKeeper expected by the nft-transfer module on
+top of the cosmossdk.io/x/nft keeper.
+
+The x/nft classes and tokens carry their metadata as an Any while ICS-721
+packets carry it as the class_data and token_data strings. The Registry converts
+between the two: chains register a MetadataConverter for the metadata types with
+a dedicated ICS-721 representation, the other types known by the codec are
+carried as the base64 of their Any JSON, and the data of unknown types is kept
+as is so that it is returned unchanged to its chain of origin.
+
+	registry := adapter.NewRegistry(appCodec)
+	nftTransferKeeper := nfttransferkeeper.NewKeeper(
+		...,
+		adapter.NewKeeper(nftKeeper, registry),
+		...,
+	)
+
+The types.UnknownMetadata stored for unknown data is registered by the
+nft-transfer module's RegisterInterfaces.
+*/
+package adapter
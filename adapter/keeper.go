@@ -0,0 +1,139 @@
+package adapter
+
+import (
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+var (
+	_ types.NFTKeeper   = Keeper{}
+	_ types.NFTIterator = Keeper{}
+)
+
+// Keeper adapts the cosmossdk.io/x/nft keeper to the NFTKeeper expected by the
+// nft-transfer module, converting the Any metadata of the classes and tokens to
+// and from the ICS-721 data with the metadata registry.
+type Keeper struct {
+	nk       nftkeeper.Keeper
+	registry *Registry
+}
+
+// NewKeeper creates a new x/nft adapter Keeper instance
+func NewKeeper(nk nftkeeper.Keeper, registry *Registry) Keeper {
+	return Keeper{nk: nk, registry: registry}
+}
+
+// CreateOrUpdateClass implements the NFTKeeper interface. The data of an existing
+// class is left unchanged when the class data is empty.
+func (k Keeper) CreateOrUpdateClass(ctx sdk.Context, classID, classURI, classData string) error {
+	metadata, err := k.registry.Decode(classData)
+	if err != nil {
+		return err
+	}
+
+	if !k.nk.HasClass(ctx, classID) {
+		return k.nk.SaveClass(ctx, nft.Class{
+			Id:   classID,
+			Uri:  classURI,
+			Data: metadata,
+		})
+	}
+	if len(classData) == 0 {
+		return nil
+	}
+
+	class, _ := k.nk.GetClass(ctx, classID)
+	class.Uri = classURI
+	class.Data = metadata
+	return k.nk.UpdateClass(ctx, class)
+}
+
+// Mint implements the NFTKeeper interface.
+func (k Keeper) Mint(ctx sdk.Context, classID, tokenID, tokenURI, tokenData string, receiver sdk.AccAddress) error {
+	metadata, err := k.registry.Decode(tokenData)
+	if err != nil {
+		return err
+	}
+	return k.nk.Mint(ctx, nft.NFT{
+		ClassId: classID,
+		Id:      tokenID,
+		Uri:     tokenURI,
+		Data:    metadata,
+	}, receiver)
+}
+
+// Transfer implements the NFTKeeper interface. The data of the token is left
+// unchanged when the token data is empty.
+func (k Keeper) Transfer(ctx sdk.Context, classID, tokenID, tokenData string, receiver sdk.AccAddress) error {
+	if err := k.nk.Transfer(ctx, classID, tokenID, receiver); err != nil {
+		return err
+	}
+	if len(tokenData) == 0 {
+		return nil
+	}
+
+	metadata, err := k.registry.Decode(tokenData)
+	if err != nil {
+		return err
+	}
+	token, _ := k.nk.GetNFT(ctx, classID, tokenID)
+	token.Data = metadata
+	return k.nk.Update(ctx, token)
+}
+
+// Burn implements the NFTKeeper interface.
+func (k Keeper) Burn(ctx sdk.Context, classID, tokenID string) error {
+	return k.nk.Burn(ctx, classID, tokenID)
+}
+
+// GetOwner implements the NFTKeeper interface.
+func (k Keeper) GetOwner(ctx sdk.Context, classID, tokenID string) sdk.AccAddress {
+	return k.nk.GetOwner(ctx, classID, tokenID)
+}
+
+// HasClass implements the NFTKeeper interface.
+func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
+	return k.nk.HasClass(ctx, classID)
+}
+
+// GetClass implements the NFTKeeper interface.
+func (k Keeper) GetClass(ctx sdk.Context, classID string) (types.Class, bool) {
+	class, found := k.nk.GetClass(ctx, classID)
+	if !found {
+		return nil, false
+	}
+	return Class{class, k.registry}, true
+}
+
+// GetNFT implements the NFTKeeper interface.
+func (k Keeper) GetNFT(ctx sdk.Context, classID, tokenID string) (types.NFT, bool) {
+	token, found := k.nk.GetNFT(ctx, classID, tokenID)
+	if !found {
+		return nil, false
+	}
+	return NFT{token, k.registry}, true
+}
+
+// IterateClasses implements the NFTIterator interface.
+func (k Keeper) IterateClasses(ctx sdk.Context, cb func(class types.Class) (stop bool)) {
+	for _, class := range k.nk.GetClasses(ctx) {
+		if cb(Class{*class, k.registry}) {
+			return
+		}
+	}
+}
+
+// IterateNFTsOfOwner implements the NFTIterator interface.
+func (k Keeper) IterateNFTsOfOwner(ctx sdk.Context, owner sdk.AccAddress, cb func(token types.NFT) (stop bool)) {
+	for _, class := range k.nk.GetClasses(ctx) {
+		for _, token := range k.nk.GetNFTsOfClassByOwner(ctx, class.Id, owner) {
+			if cb(NFT{token, k.registry}) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package adapter_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bianjieai/nft-transfer/adapter"
+	"github.com/bianjieai/nft-transfer/testing/mock"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+var (
+	owner    = sdk.AccAddress("owner_______________")
+	receiver = sdk.AccAddress("receiver____________")
+)
+
+// AdapterTestSuite is the conformance test suite of the x/nft adapter. It checks
+// that:
+//   - empty ICS-721 data and nil metadata are converted to one another;
+//   - the metadata of the types known by the codec round trip through the
+//     base64 of their Any JSON;
+//   - the ICS-721 data of unknown types round trips byte for byte;
+//   - the registered converters take precedence over the codec;
+//   - the Keeper implements the NFTKeeper semantics the module relies on, in
+//     particular that empty class and token data leave the existing metadata
+//     unchanged.
+type AdapterTestSuite struct {
+	suite.Suite
+
+	ctx      sdk.Context
+	cdc      codec.Codec
+	nk       nftkeeper.Keeper
+	registry *adapter.Registry
+	keeper   adapter.Keeper
+}
+
+func (suite *AdapterTestSuite) SetupTest() {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	nft.RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	mock.RegisterImplementations(interfaceRegistry)
+	suite.cdc = codec.NewProtoCodec(interfaceRegistry)
+
+	key := storetypes.NewKVStoreKey(nft.StoreKey)
+	suite.ctx = testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	suite.nk = nftkeeper.NewKeeper(runtime.NewKVStoreService(key), suite.cdc, accountKeeper{}, nil)
+	suite.registry = adapter.NewRegistry(suite.cdc)
+	suite.keeper = adapter.NewKeeper(suite.nk, suite.registry)
+}
+
+func TestAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(AdapterTestSuite))
+}
+
+func (suite *AdapterTestSuite) TestRegistryEmpty() {
+	data, err := suite.registry.Encode(nil)
+	suite.Require().NoError(err)
+	suite.Require().Empty(data)
+
+	metadata, err := suite.registry.Decode("")
+	suite.Require().NoError(err)
+	suite.Require().Nil(metadata)
+}
+
+func (suite *AdapterTestSuite) TestRegistryKnownType() {
+	metadata, err := codectypes.NewAnyWithValue(&mock.TokenMetadata{Name: "kitty", Data: "{\"color\":\"black\"}"})
+	suite.Require().NoError(err)
+
+	data, err := suite.registry.Encode(metadata)
+	suite.Require().NoError(err)
+	bz, err := base64.RawStdEncoding.DecodeString(data)
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(bz), "/mock.TokenMetadata")
+
+	decoded, err := suite.registry.Decode(data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata.TypeUrl, decoded.TypeUrl)
+	suite.Require().Equal(metadata.Value, decoded.Value)
+}
+
+func (suite *AdapterTestSuite) TestRegistryUnknownType() {
+	testCases := []struct {
+		msg  string
+		data string
+	}{
+		{"plain text", "kitty"},
+		{"ICS-721 JSON", "{\"name\":{\"value\":\"kitty\"}}"},
+		{"base64 of ICS-721 JSON", base64.StdEncoding.EncodeToString([]byte("{\"name\":{\"value\":\"kitty\"}}"))},
+		{"base64 of an unregistered Any", base64.RawStdEncoding.EncodeToString([]byte("{\"@type\":\"/other.Metadata\",\"name\":\"kitty\"}"))},
+		{"base64 of a known Any with unknown fields", base64.RawStdEncoding.EncodeToString([]byte("{\"@type\":\"/mock.TokenMetadata\",\"owner\":\"kitty\"}"))},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			metadata, err := suite.registry.Decode(tc.data)
+			suite.Require().NoError(err)
+			suite.Require().Equal(adapter.UnknownMetadataTypeURL, metadata.TypeUrl)
+
+			data, err := suite.registry.Encode(metadata)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.data, data)
+		})
+	}
+}
+
+func (suite *AdapterTestSuite) TestRegistryConverter() {
+	suite.registry.RegisterConverter(nameConverter{})
+
+	metadata, err := codectypes.NewAnyWithValue(&mock.TokenMetadata{Name: "kitty"})
+	suite.Require().NoError(err)
+	data, err := suite.registry.Encode(metadata)
+	suite.Require().NoError(err)
+	suite.Require().Equal("{\"name\":{\"value\":\"kitty\"}}", data)
+
+	decoded, err := suite.registry.Decode(data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, decoded)
+
+	// the data the converter does not decode falls back to the codec
+	classMetadata, err := codectypes.NewAnyWithValue(&mock.ClassMetadata{Creator: owner.String()})
+	suite.Require().NoError(err)
+	data, err = suite.registry.Encode(classMetadata)
+	suite.Require().NoError(err)
+	decoded, err = suite.registry.Decode(data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(classMetadata.Value, decoded.Value)
+
+	suite.Require().Panics(func() { suite.registry.RegisterConverter(nameConverter{}) })
+	suite.Require().Panics(func() { suite.registry.RegisterConverter(unknownConverter{}) })
+}
+
+func (suite *AdapterTestSuite) TestCreateOrUpdateClass() {
+	classData := "{\"name\":{\"value\":\"cat\"}}"
+	suite.Require().NoError(suite.keeper.CreateOrUpdateClass(suite.ctx, "cat", "uri", classData))
+	suite.Require().True(suite.keeper.HasClass(suite.ctx, "cat"))
+	suite.assertClass("cat", "uri", classData)
+
+	// empty class data leaves the class unchanged
+	suite.Require().NoError(suite.keeper.CreateOrUpdateClass(suite.ctx, "cat", "new-uri", ""))
+	suite.assertClass("cat", "uri", classData)
+
+	suite.Require().NoError(suite.keeper.CreateOrUpdateClass(suite.ctx, "cat", "new-uri", "cat"))
+	suite.assertClass("cat", "new-uri", "cat")
+
+	suite.Require().NoError(suite.keeper.CreateOrUpdateClass(suite.ctx, "dog", "", ""))
+	suite.assertClass("dog", "", "")
+
+	_, found := suite.keeper.GetClass(suite.ctx, "cow")
+	suite.Require().False(found)
+	suite.Require().False(suite.keeper.HasClass(suite.ctx, "cow"))
+}
+
+func (suite *AdapterTestSuite) TestMintTransferBurn() {
+	tokenData := "{\"name\":{\"value\":\"kitty\"}}"
+	suite.Require().NoError(suite.keeper.CreateOrUpdateClass(suite.ctx, "cat", "", ""))
+	suite.Require().NoError(suite.keeper.Mint(suite.ctx, "cat", "kitty", "uri", tokenData, owner))
+	suite.Require().Error(suite.keeper.Mint(suite.ctx, "cat", "kitty", "uri", tokenData, owner))
+	suite.Require().Error(suite.keeper.Mint(suite.ctx, "dog", "puppy", "uri", tokenData, owner))
+	suite.Require().Equal(owner, suite.keeper.GetOwner(suite.ctx, "cat", "kitty"))
+	suite.assertNFT("cat", "kitty", "uri", tokenData)
+
+	// empty token data leaves the token unchanged
+	suite.Require().NoError(suite.keeper.Transfer(suite.ctx, "cat", "kitty", "", receiver))
+	suite.Require().Equal(receiver, suite.keeper.GetOwner(suite.ctx, "cat", "kitty"))
+	suite.assertNFT("cat", "kitty", "uri", tokenData)
+
+	suite.Require().NoError(suite.keeper.Transfer(suite.ctx, "cat", "kitty", "kitty", owner))
+	suite.Require().Equal(owner, suite.keeper.GetOwner(suite.ctx, "cat", "kitty"))
+	suite.assertNFT("cat", "kitty", "uri", "kitty")
+
+	suite.Require().Error(suite.keeper.Transfer(suite.ctx, "cat", "puppy", "", owner))
+
+	suite.Require().NoError(suite.keeper.Burn(suite.ctx, "cat", "kitty"))
+	suite.Require().Nil(suite.keeper.GetOwner(suite.ctx, "cat", "kitty"))
+	_, found := suite.keeper.GetNFT(suite.ctx, "cat", "kitty")
+	suite.Require().False(found)
+	suite.Require().Error(suite.keeper.Burn(suite.ctx, "cat", "kitty"))
+}
+
+func (suite *AdapterTestSuite) TestIterate() {
+	for _, classID := range []string{"cat", "dog"} {
+		suite.Require().NoError(suite.keeper.CreateOrUpdateClass(suite.ctx, classID, "", ""))
+		suite.Require().NoError(suite.keeper.Mint(suite.ctx, classID, classID+"1", "", "", owner))
+		suite.Require().NoError(suite.keeper.Mint(suite.ctx, classID, classID+"2", "", "", receiver))
+	}
+
+	var classIDs []string
+	suite.keeper.IterateClasses(suite.ctx, func(class types.Class) bool {
+		classIDs = append(classIDs, class.GetID())
+		return false
+	})
+	suite.Require().Equal([]string{"cat", "dog"}, classIDs)
+
+	var tokenIDs []string
+	suite.keeper.IterateNFTsOfOwner(suite.ctx, owner, func(token types.NFT) bool {
+		tokenIDs = append(tokenIDs, token.GetClassID()+"/"+token.GetID())
+		return false
+	})
+	suite.Require().Equal([]string{"cat/cat1", "dog/dog1"}, tokenIDs)
+
+	tokenIDs = nil
+	suite.keeper.IterateNFTsOfOwner(suite.ctx, owner, func(token types.NFT) bool {
+		tokenIDs = append(tokenIDs, token.GetID())
+		return true
+	})
+	suite.Require().Equal([]string{"cat1"}, tokenIDs)
+}
+
+func (suite *AdapterTestSuite) assertClass(classID, classURI, classData string) {
+	class, found := suite.keeper.GetClass(suite.ctx, classID)
+	suite.Require().True(found)
+	suite.Require().Equal(classID, class.GetID())
+	suite.Require().Equal(classURI, class.GetURI())
+	suite.Require().Equal(classData, class.GetData())
+}
+
+func (suite *AdapterTestSuite) assertNFT(classID, tokenID, tokenURI, tokenData string) {
+	token, found := suite.keeper.GetNFT(suite.ctx, classID, tokenID)
+	suite.Require().True(found)
+	suite.Require().Equal(classID, token.GetClassID())
+	suite.Require().Equal(tokenID, token.GetID())
+	suite.Require().Equal(tokenURI, token.GetURI())
+	suite.Require().Equal(tokenData, token.GetData())
+}
+
+// nameConverter converts a mock.TokenMetadata to and from the ICS-721 JSON of
+// its name.
+type nameConverter struct{}
+
+type nameData struct {
+	Name struct {
+		Value string `json:"value"`
+	} `json:"name"`
+}
+
+func (nameConverter) TypeURL() string {
+	return "/mock.TokenMetadata"
+}
+
+func (nameConverter) Encode(metadata *codectypes.Any) (string, error) {
+	var tokenMetadata mock.TokenMetadata
+	if err := tokenMetadata.Unmarshal(metadata.Value); err != nil {
+		return "", err
+	}
+	var data nameData
+	data.Name.Value = tokenMetadata.Name
+	bz, err := json.Marshal(data)
+	return string(bz), err
+}
+
+func (nameConverter) Decode(data string) (*codectypes.Any, bool) {
+	var name nameData
+	if err := json.Unmarshal([]byte(data), &name); err != nil || name.Name.Value == "" {
+		return nil, false
+	}
+	metadata, err := codectypes.NewAnyWithValue(&mock.TokenMetadata{Name: name.Name.Value})
+	return metadata, err == nil
+}
+
+type unknownConverter struct{ nameConverter }
+
+func (unknownConverter) TypeURL() string {
+	return adapter.UnknownMetadataTypeURL
+}
+
+// accountKeeper is the minimal account keeper required by the x/nft keeper.
+type accountKeeper struct{}
+
+func (accountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func (accountKeeper) GetAccount(context.Context, sdk.AccAddress) sdk.AccountI {
+	return nil
+}
+
+func (accountKeeper) AddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.Bech32MainPrefix)
+}
@@ -0,0 +1,120 @@
+package adapter
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// UnknownMetadataTypeURL is the type URL of the metadata holding the ICS-721
+// data which none of the converters of the registry is able to decode.
+var UnknownMetadataTypeURL = codectypes.MsgTypeURL(&types.UnknownMetadata{})
+
+// MetadataConverter converts the metadata of one type between the Any stored by
+// x/nft and the ICS-721 class_data or token_data of a packet.
+type MetadataConverter interface {
+	// TypeURL returns the type URL of the metadata converted.
+	TypeURL() string
+	// Encode returns the ICS-721 data of the metadata.
+	Encode(metadata *codectypes.Any) (string, error)
+	// Decode returns the metadata of the ICS-721 data, ok is false if the data
+	// is not of the converted type.
+	Decode(data string) (metadata *codectypes.Any, ok bool)
+}
+
+// Registry converts the metadata of the x/nft classes and tokens to and from the
+// ICS-721 data using the registered converters.
+//
+// The metadata of a type without a converter is encoded as the base64 of its
+// Any JSON, and the ICS-721 data no converter decodes is decoded from the base64
+// Any JSON when its type is known by the codec. Any other ICS-721 data is kept
+// as is in an UnknownMetadata, which is encoded back to the very same data so
+// that tokens of other chains are returned unchanged.
+type Registry struct {
+	cdc        codec.Codec
+	converters []MetadataConverter
+}
+
+// NewRegistry creates a new Registry with the given converters.
+func NewRegistry(cdc codec.Codec, converters ...MetadataConverter) *Registry {
+	r := &Registry{cdc: cdc}
+	for _, converter := range converters {
+		r.RegisterConverter(converter)
+	}
+	return r
+}
+
+// RegisterConverter registers the converter of a metadata type. The converters
+// are tried in registration order to decode the ICS-721 data. It panics if a
+// converter is already registered for the type.
+func (r *Registry) RegisterConverter(converter MetadataConverter) {
+	typeURL := converter.TypeURL()
+	if typeURL == UnknownMetadataTypeURL {
+		panic(fmt.Sprintf("cannot register a metadata converter for %s", typeURL))
+	}
+	if r.getConverter(typeURL) != nil {
+		panic(fmt.Sprintf("metadata converter for %s already registered", typeURL))
+	}
+	r.converters = append(r.converters, converter)
+}
+
+// Encode returns the ICS-721 data of the metadata.
+func (r Registry) Encode(metadata *codectypes.Any) (string, error) {
+	if metadata == nil {
+		return "", nil
+	}
+
+	if metadata.TypeUrl == UnknownMetadataTypeURL {
+		var unknown types.UnknownMetadata
+		if err := unknown.Unmarshal(metadata.Value); err != nil {
+			return "", errorsmod.Wrap(types.ErrMarshal, err.Error())
+		}
+		return unknown.Data, nil
+	}
+
+	if converter := r.getConverter(metadata.TypeUrl); converter != nil {
+		return converter.Encode(metadata)
+	}
+
+	bz, err := r.cdc.MarshalJSON(metadata)
+	if err != nil {
+		return "", errorsmod.Wrapf(types.ErrMarshal, "metadata %s: %s", metadata.TypeUrl, err)
+	}
+	return base64.RawStdEncoding.EncodeToString(bz), nil
+}
+
+// Decode returns the metadata of the ICS-721 data.
+func (r Registry) Decode(data string) (*codectypes.Any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	for _, converter := range r.converters {
+		if metadata, ok := converter.Decode(data); ok {
+			return metadata, nil
+		}
+	}
+
+	if bz, err := base64.RawStdEncoding.DecodeString(data); err == nil {
+		var metadata codectypes.Any
+		if err := r.cdc.UnmarshalJSON(bz, &metadata); err == nil {
+			return &metadata, nil
+		}
+	}
+	return codectypes.NewAnyWithValue(&types.UnknownMetadata{Data: data})
+}
+
+func (r Registry) getConverter(typeURL string) MetadataConverter {
+	for _, converter := range r.converters {
+		if converter.TypeURL() == typeURL {
+			return converter
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package adapter
+
+import (
+	"cosmossdk.io/x/nft"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+var (
+	_ types.Class = Class{}
+	_ types.NFT   = NFT{}
+)
+
+// Class wraps an x/nft class to expose its metadata as ICS-721 class data.
+type Class struct {
+	nft.Class
+	registry *Registry
+}
+
+// GetID implements the Class interface.
+func (c Class) GetID() string {
+	return c.Id
+}
+
+// GetURI implements the Class interface.
+func (c Class) GetURI() string {
+	return c.Uri
+}
+
+// GetData implements the Class interface. The class data is empty if the
+// metadata cannot be encoded.
+func (c Class) GetData() string {
+	data, _ := c.registry.Encode(c.Data)
+	return data
+}
+
+// NFT wraps an x/nft token to expose its metadata as ICS-721 token data.
+type NFT struct {
+	nft.NFT
+	registry *Registry
+}
+
+// GetClassID implements the NFT interface.
+func (t NFT) GetClassID() string {
+	return t.ClassId
+}
+
+// GetID implements the NFT interface.
+func (t NFT) GetID() string {
+	return t.Id
+}
+
+// GetURI implements the NFT interface.
+func (t NFT) GetURI() string {
+	return t.Uri
+}
+
+// GetData implements the NFT interface. The token data is empty if the
+// metadata cannot be encoded.
+func (t NFT) GetData() string {
+	data, _ := t.registry.Encode(t.Data)
+	return data
+}
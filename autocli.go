@@ -0,0 +1,229 @@
+package nfttransfer
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface. The query
+// and transaction commands are added to the custom commands of the module,
+// the transfer command being kept for the computation of relative timeouts.
+func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service:              types.Query_serviceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "ClassTrace",
+					Use:            "class-trace [hash/class]",
+					Short:          "Query the class trace info from a given trace hash or ibc class",
+					Example:        fmt.Sprintf("%s query nft-transfer class-trace 27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "hash"}},
+				},
+				{
+					RpcMethod: "ClassTraces",
+					Use:       "class-traces",
+					Short:     "Query the trace info for all the class",
+					Example:   fmt.Sprintf("%s query nft-transfer class-traces", version.AppName),
+				},
+				{
+					RpcMethod:      "ClassHash",
+					Use:            "class-hash [trace]",
+					Short:          "Query the class hash info from a given class trace",
+					Example:        fmt.Sprintf("%s query nft-transfer class-hash nft-transfer/channel-0/class-id", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "trace"}},
+				},
+				{
+					RpcMethod:      "EscrowAddress",
+					Use:            "escrow-address [port-id] [channel-id]",
+					Short:          "Get the escrow address for a channel",
+					Example:        fmt.Sprintf("%s query nft-transfer escrow-address nft-transfer channel-0", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "port_id"}, {ProtoField: "channel_id"}},
+				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the params from the nft-transfer module",
+					Example:   fmt.Sprintf("%s query nft-transfer params", version.AppName),
+				},
+				{
+					RpcMethod:      "ChannelParams",
+					Use:            "channel-params [port-id] [channel-id]",
+					Short:          "Query the effective send and receive params of a particular channel",
+					Long:           "Query the effective send and receive params of a particular channel, taking both the global and the channel params into account",
+					Example:        fmt.Sprintf("%s query nft-transfer channel-params nft-transfer channel-0", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "port_id"}, {ProtoField: "channel_id"}},
+				},
+				{
+					RpcMethod: "RateLimits",
+					Use:       "rate-limits",
+					Short:     "Query all the rate limits with their current usage",
+					Example:   fmt.Sprintf("%s query nft-transfer rate-limits", version.AppName),
+				},
+				{
+					RpcMethod:      "RateLimit",
+					Use:            "rate-limit [port-id] [channel-id] [class-id]",
+					Short:          "Query the rate limit of a channel and class with its current usage",
+					Example:        fmt.Sprintf("%s query nft-transfer rate-limit nft-transfer channel-0 cryptoCat", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "port_id"}, {ProtoField: "channel_id"}, {ProtoField: "class_id"}},
+				},
+				{
+					RpcMethod:      "EscrowedTokens",
+					Use:            "escrowed-tokens [port-id] [channel-id]",
+					Short:          "Query the tokens held by the escrow account of a channel",
+					Example:        fmt.Sprintf("%s query nft-transfer escrowed-tokens nft-transfer channel-0 --class-id cryptoCat", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "port_id"}, {ProtoField: "channel_id"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"class_id": {Usage: "Restrict the escrowed tokens to the given class"},
+					},
+				},
+				{
+					RpcMethod:      "EscrowedClassCounts",
+					Use:            "escrowed-class-counts [port-id] [channel-id]",
+					Short:          "Query the number of tokens of each class held by the escrow account of a channel",
+					Example:        fmt.Sprintf("%s query nft-transfer escrowed-class-counts nft-transfer channel-0", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "port_id"}, {ProtoField: "channel_id"}},
+				},
+				{
+					RpcMethod:      "TokenEscrow",
+					Use:            "token-escrow [class-id] [token-id]",
+					Short:          "Query the channel whose escrow account holds a token",
+					Example:        fmt.Sprintf("%s query nft-transfer token-escrow cryptoCat kitty", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "class_id"}, {ProtoField: "token_id"}},
+				},
+				{
+					RpcMethod: "RefundRecords",
+					Use:       "refund-records",
+					Short:     "Query the refund addresses of the packets not yet resolved",
+					Example:   fmt.Sprintf("%s query nft-transfer refund-records", version.AppName),
+				},
+				{
+					RpcMethod:      "RefundRecord",
+					Use:            "refund-record [port-id] [channel-id] [sequence]",
+					Short:          "Query the refund address of a packet not yet resolved",
+					Example:        fmt.Sprintf("%s query nft-transfer refund-record nft-transfer channel-0 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "port_id"}, {ProtoField: "channel_id"}, {ProtoField: "sequence"}},
+				},
+				{
+					RpcMethod: "EscrowReleaseRecords",
+					Use:       "escrow-release-records",
+					Short:     "Query the records of the tokens released from the escrow accounts by governance",
+					Example:   fmt.Sprintf("%s query nft-transfer escrow-release-records", version.AppName),
+				},
+				{
+					RpcMethod: "Pauses",
+					Use:       "pauses",
+					Short:     "Query the pauses of the transfers over channels and of classes",
+					Example:   fmt.Sprintf("%s query nft-transfer pauses", version.AppName),
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              types.Msg_serviceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Transfer",
+					Skip:      true, // the custom transfer command computes the relative timeouts
+				},
+				{
+					RpcMethod: "BatchTransfer",
+					Skip:      true, // the custom batch-transfer command reads the tokens from a file
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Use:       "update-params [params]",
+					Short:     "Update the params of the nft-transfer module",
+					Long:      "Update the params of the nft-transfer module. The signer must be the authority, the message being usually submitted through a governance proposal with --generate-only.",
+					Example: fmt.Sprintf(
+						`%s tx nft-transfer update-params '{"send_enabled":true,"receive_enabled":true}' --from [authority] --generate-only`,
+						version.AppName,
+					),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+				},
+				{
+					RpcMethod: "SetRateLimit",
+					Use:       "set-rate-limit [rate-limit]",
+					Short:     "Set the rate limit of a channel and class",
+					Long:      "Set the rate limit of a channel and class. The signer must be the authority, the message being usually submitted through a governance proposal with --generate-only.",
+					Example: fmt.Sprintf(
+						`%s tx nft-transfer set-rate-limit '{"port_id":"nft-transfer","channel_id":"channel-0","class_id":"cryptoCat","max_send":10,"max_receive":10,"window":"3600s"}' --from [authority] --generate-only`,
+						version.AppName,
+					),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "rate_limit"}},
+				},
+				{
+					RpcMethod: "RemoveRateLimit",
+					Use:       "remove-rate-limit [port-id] [channel-id] [class-id]",
+					Short:     "Remove the rate limit of a channel and class",
+					Long:      "Remove the rate limit of a channel and class. The signer must be the authority, the message being usually submitted through a governance proposal with --generate-only.",
+					Example: fmt.Sprintf(
+						"%s tx nft-transfer remove-rate-limit nft-transfer channel-0 cryptoCat --from [authority] --generate-only",
+						version.AppName,
+					),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "port_id"}, {ProtoField: "channel_id"}, {ProtoField: "class_id"}},
+				},
+				{
+					RpcMethod: "MigrateEscrow",
+					Use:       "migrate-escrow [source-port-id] [source-channel-id] [destination-port-id] [destination-channel-id]",
+					Short:     "Move the tokens escrowed by an unusable channel to the escrow of another channel",
+					Long:      "Move the tokens escrowed by a closed channel or a channel whose client is no longer active to the escrow of another open channel, restricted to a class using the --class-id flag. The signer must be the authority, the message being usually submitted through a governance proposal with --generate-only.",
+					Example: fmt.Sprintf(
+						"%s tx nft-transfer migrate-escrow nft-transfer channel-0 nft-transfer channel-1 --from [authority] --generate-only",
+						version.AppName,
+					),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "source_port_id"},
+						{ProtoField: "source_channel_id"},
+						{ProtoField: "destination_port_id"},
+						{ProtoField: "destination_channel_id"},
+					},
+				},
+				{
+					RpcMethod: "ReleaseEscrow",
+					Use:       "release-escrow [port-id] [channel-id] [evidence] [release]...",
+					Short:     "Release the tokens escrowed by an unusable channel to their owners",
+					Long:      "Release the tokens escrowed by a channel whose client is no longer active to the named owners, with the evidence recorded on-chain. The signer must be the authority, the message being usually submitted through a governance proposal with --generate-only.",
+					Example: fmt.Sprintf(
+						`%s tx nft-transfer release-escrow nft-transfer channel-0 ipfs://evidence '{"class_id":"cryptoCat","token_id":"kitty","receiver":"cosmos1..."}' --from [authority] --generate-only`,
+						version.AppName,
+					),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "port_id"},
+						{ProtoField: "channel_id"},
+						{ProtoField: "evidence"},
+						{ProtoField: "releases", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "Pause",
+					Use:       "pause [pause]",
+					Short:     "Pause the transfers of non-fungible tokens over a channel or of a class",
+					Long:      "Pause the sending and receiving of non-fungible tokens over a channel, of a class over all the channels or of a class over a channel. The signer must be the authority or the guardian of the module params.",
+					Example: fmt.Sprintf(
+						`%s tx nft-transfer pause '{"port_id":"nft-transfer","channel_id":"channel-0","class_id":"kitty"}' --from [guardian]`,
+						version.AppName,
+					),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pause"}},
+				},
+				{
+					RpcMethod: "Unpause",
+					Use:       "unpause [pause]",
+					Short:     "Lift a pause of the transfers of non-fungible tokens over a channel or of a class",
+					Long:      "Lift a pause exactly as it was set. The signer must be the authority or the guardian of the module params.",
+					Example: fmt.Sprintf(
+						`%s tx nft-transfer unpause '{"port_id":"nft-transfer","channel_id":"channel-0","class_id":"kitty"}' --from [guardian]`,
+						version.AppName,
+					),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pause"}},
+				},
+			},
+		},
+	}
+}
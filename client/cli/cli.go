@@ -6,27 +6,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 )
 
-// GetQueryCmd returns the query commands for IBC connections
+// GetQueryCmd returns the query commands for IBC non-fungible token transfer,
+// which are generated by autocli from the Query service.
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
 		Use:                        "nft-transfer",
 		Short:                      "IBC non-fungible token transfer query subcommands",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
 	}
 
-	queryCmd.AddCommand(
-		GetCmdQueryClassTrace(),
-		GetCmdQueryClassTraces(),
-		GetCmdQueryEscrowAddress(),
-		GetCmdQueryClassHash(),
-		GetCmdQueryParams(),
-	)
-
 	return queryCmd
 }
 
 // NewTxCmd returns the transaction commands for IBC non-fungible token transfer
+// which are not generated by autocli from the Msg service.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:                        "nft-transfer",
@@ -38,6 +33,8 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewTransferTxCmd(),
+		NewBatchTransferTxCmd(),
+		NewGrantTransferAuthorizationCmd(),
 	)
 
 	return txCmd
@@ -0,0 +1,333 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/core/appmodule"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	nfttransfer "github.com/bianjieai/nft-transfer"
+	"github.com/bianjieai/nft-transfer/testing/simapp"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+const guardianMnemonic = "notice oak worry limit wrap speak medal online prefer cluster roof addict wrist behave treat actual wasp year salad speed social layer crew genius"
+
+// CLITestSuite runs the commands of the module against an in-process network.
+type CLITestSuite struct {
+	suite.Suite
+
+	network  *network.Network
+	guardian sdk.AccAddress
+}
+
+func TestCLITestSuite(t *testing.T) {
+	suite.Run(t, new(CLITestSuite))
+}
+
+func (s *CLITestSuite) SetupSuite() {
+	cfg := network.DefaultConfig(simapp.NewTestNetworkFixture)
+	cfg.NumValidators = 1
+
+	// the guardian of the params, funded at genesis, pauses the transfers
+	kr := keyring.NewInMemory(cfg.Codec)
+	record, err := kr.NewAccount("guardian", guardianMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	s.Require().NoError(err)
+	s.guardian, err = record.GetAddress()
+	s.Require().NoError(err)
+
+	var authGenesis authtypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[authtypes.ModuleName], &authGenesis)
+	accounts, err := authtypes.PackAccounts(authtypes.GenesisAccounts{authtypes.NewBaseAccount(s.guardian, nil, 0, 0)})
+	s.Require().NoError(err)
+	authGenesis.Accounts = append(authGenesis.Accounts, accounts...)
+	cfg.GenesisState[authtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&authGenesis)
+
+	var bankGenesis banktypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[banktypes.ModuleName], &bankGenesis)
+	bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+		Address: s.guardian.String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, sdkmath.NewInt(1_000_000_000))),
+	})
+	cfg.GenesisState[banktypes.ModuleName] = cfg.Codec.MustMarshalJSON(&bankGenesis)
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params.Guardian = s.guardian.String()
+	cfg.GenesisState[types.ModuleName] = cfg.Codec.MustMarshalJSON(genesis)
+
+	s.network, err = network.New(s.T(), s.T().TempDir(), cfg)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	_, err = s.network.Validators[0].ClientCtx.Keyring.NewAccount("guardian", guardianMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	s.Require().NoError(err)
+}
+
+func (s *CLITestSuite) TearDownSuite() {
+	s.network.Cleanup()
+}
+
+// TestQueryCmds tests the query commands generated by autocli.
+func (s *CLITestSuite) TestQueryCmds() {
+	params := types.DefaultParams()
+	params.Guardian = s.guardian.String()
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+		resp   proto.Message
+		expRes proto.Message
+	}{
+		{
+			"params",
+			[]string{"params"},
+			false,
+			&types.QueryParamsResponse{},
+			&types.QueryParamsResponse{Params: params},
+		},
+		{
+			"channel params",
+			[]string{"channel-params", types.PortID, "channel-0"},
+			false,
+			&types.QueryChannelParamsResponse{},
+			&types.QueryChannelParamsResponse{ChannelParams: types.NewChannelParams(types.PortID, "channel-0", true, true)},
+		},
+		{
+			"escrow address",
+			[]string{"escrow-address", types.PortID, "channel-0"},
+			false,
+			&types.QueryEscrowAddressResponse{},
+			&types.QueryEscrowAddressResponse{EscrowAddress: types.GetEscrowAddress(types.PortID, "channel-0").String()},
+		},
+		{
+			"class hash not found",
+			[]string{"class-hash", "nft-transfer/channel-0/cryptoCat"},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"class traces",
+			[]string{"class-traces"},
+			false,
+			&types.QueryClassTracesResponse{},
+			&types.QueryClassTracesResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			"class trace not found",
+			[]string{"class-trace", types.ParseClassTrace("nft-transfer/channel-0/cryptoCat").IBCClassID()},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"escrowed tokens of a class",
+			[]string{"escrowed-tokens", types.PortID, "channel-0", "--class-id", "cryptoCat"},
+			false,
+			&types.QueryEscrowedTokensResponse{},
+			&types.QueryEscrowedTokensResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			"refund record not found",
+			[]string{"refund-record", types.PortID, "channel-0", "1"},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"invalid number of arguments",
+			[]string{"rate-limit", types.PortID, "channel-0"},
+			true,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			args := append([]string{"query", "nft-transfer"}, tc.args...)
+			out, err := s.execCmd(append(args, fmt.Sprintf("--%s=json", flags.FlagOutput))...)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err, out)
+			s.Require().NoError(s.network.Validators[0].ClientCtx.Codec.UnmarshalJSON([]byte(out), tc.resp), out)
+			s.Require().Equal(tc.expRes.String(), tc.resp.String())
+		})
+	}
+}
+
+// TestPauseCmds tests that the guardian pauses and unpauses the transfers of a
+// class with the transaction commands generated by autocli.
+func (s *CLITestSuite) TestPauseCmds() {
+	pause := types.NewPause("", "", "cryptoCat")
+	bz, err := json.Marshal(pause)
+	s.Require().NoError(err)
+
+	for _, cmd := range []string{"pause", "unpause"} {
+		out, err := s.execCmd(append([]string{"tx", "nft-transfer", cmd, string(bz)}, s.txFlags("guardian")...)...)
+		s.Require().NoError(err, out)
+		var txRes sdk.TxResponse
+		s.Require().NoError(s.network.Validators[0].ClientCtx.Codec.UnmarshalJSON([]byte(out), &txRes), out)
+		s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+		s.Require().NoError(s.network.WaitForNextBlock())
+
+		out, err = s.execCmd("query", "nft-transfer", "pauses", fmt.Sprintf("--%s=json", flags.FlagOutput))
+		s.Require().NoError(err, out)
+		var res types.QueryPausesResponse
+		s.Require().NoError(s.network.Validators[0].ClientCtx.Codec.UnmarshalJSON([]byte(out), &res), out)
+		if cmd == "pause" {
+			s.Require().Equal([]types.Pause{pause}, res.Pauses)
+		} else {
+			s.Require().Empty(res.Pauses)
+		}
+	}
+
+	// the validator is neither the authority nor the guardian
+	out, err := s.execCmd(append([]string{"tx", "nft-transfer", "pause", string(bz)}, s.txFlags(s.network.Validators[0].Address.String())...)...)
+	s.Require().NoError(err, out)
+	var txRes sdk.TxResponse
+	s.Require().NoError(s.network.Validators[0].ClientCtx.Codec.UnmarshalJSON([]byte(out), &txRes), out)
+	s.Require().NoError(s.network.WaitForNextBlock())
+	txRes2, err := clitestutil.GetTxResponse(s.network, s.network.Validators[0].ClientCtx, txRes.TxHash)
+	s.Require().NoError(err)
+	s.Require().Equal(govtypes.ErrInvalidSigner.ABCICode(), txRes2.Code, txRes2.RawLog)
+}
+
+// TestGovCmds tests that the messages of the authority are generated by the
+// commands of autocli for governance proposals.
+func (s *CLITestSuite) TestGovCmds() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	params := types.DefaultParams()
+	params.SendDeniedClasses = []string{"cryptoCat"}
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expMsg sdk.Msg
+	}{
+		{
+			"update params",
+			[]string{"update-params", `{"send_enabled":true,"receive_enabled":true,"send_denied_classes":["cryptoCat"]}`},
+			&types.MsgUpdateParams{Authority: authority, Params: params},
+		},
+		{
+			"remove rate limit",
+			[]string{"remove-rate-limit", types.PortID, "channel-0", "cryptoCat"},
+			&types.MsgRemoveRateLimit{Authority: authority, PortId: types.PortID, ChannelId: "channel-0", ClassId: "cryptoCat"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			args := append([]string{"tx", "nft-transfer"}, tc.args...)
+			out, err := s.execCmd(append(args,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, authority),
+				fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+			)...)
+			s.Require().NoError(err, out)
+
+			tx, err := s.network.Validators[0].ClientCtx.TxConfig.TxJSONDecoder()([]byte(out))
+			s.Require().NoError(err, out)
+			s.Require().Equal([]sdk.Msg{tc.expMsg}, tx.GetMsgs())
+		})
+	}
+}
+
+// TestBatchTransferCmd tests that the batch-transfer command reads the tokens
+// of the classes from a file.
+func (s *CLITestSuite) TestBatchTransferCmd() {
+	classTokens := []types.ClassTokens{
+		{ClassId: "cryptoCat", TokenIds: []string{"kitty1", "kitty2"}},
+		{ClassId: "cryptoDog", TokenIds: []string{"puppy"}},
+	}
+	bz, err := json.Marshal(classTokens)
+	s.Require().NoError(err)
+	file := filepath.Join(s.T().TempDir(), "class_tokens.json")
+	s.Require().NoError(os.WriteFile(file, bz, 0o600))
+
+	sender := s.network.Validators[0].Address.String()
+	out, err := s.execCmd(
+		"tx", "nft-transfer", "batch-transfer", types.PortID, "channel-0", s.guardian.String(), file,
+		fmt.Sprintf("--%s=0-100", "packet-timeout-height"),
+		fmt.Sprintf("--%s=true", "absolute-timeouts"),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, sender),
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err, out)
+
+	tx, err := s.network.Validators[0].ClientCtx.TxConfig.TxJSONDecoder()([]byte(out))
+	s.Require().NoError(err, out)
+	expMsg := types.NewMsgBatchTransfer(
+		types.PortID, "channel-0", classTokens, sender, s.guardian.String(),
+		clienttypes.NewHeight(0, 100), types.DefaultRelativePacketTimeoutTimestamp, "",
+	)
+	s.Require().Equal([]sdk.Msg{expMsg}, tx.GetMsgs())
+
+	// the file must hold the classes and their tokens
+	s.Require().NoError(os.WriteFile(file, []byte("cryptoCat"), 0o600))
+	_, err = s.execCmd(
+		"tx", "nft-transfer", "batch-transfer", types.PortID, "channel-0", s.guardian.String(), file,
+		fmt.Sprintf("--%s=true", "absolute-timeouts"),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, sender),
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	)
+	s.Require().Error(err)
+}
+
+// execCmd executes the commands generated by autocli for the module, along with
+// its custom commands, with the client context of the validator.
+func (s *CLITestSuite) execCmd(args ...string) (string, error) {
+	rootCmd := &cobra.Command{Use: "simd"}
+	appOpts := autocli.AppOptions{
+		Modules:               map[string]appmodule.AppModule{types.ModuleName: nfttransfer.AppModule{}},
+		AddressCodec:          addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		ValidatorAddressCodec: addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
+		ConsensusAddressCodec: addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
+		ClientCtx:             s.network.Validators[0].ClientCtx,
+	}
+	s.Require().NoError(appOpts.EnhanceRootCommand(rootCmd))
+
+	out, err := clitestutil.ExecTestCLICmd(s.network.Validators[0].ClientCtx, rootCmd, args)
+	return out.String(), err
+}
+
+// txFlags returns the flags broadcasting a transaction signed by the given key.
+func (s *CLITestSuite) txFlags(from string) []string {
+	return []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.network.Config.BondDenom, sdkmath.NewInt(10))).String()),
+		fmt.Sprintf("--%s=json", flags.FlagOutput),
+	}
+}
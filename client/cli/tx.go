@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -11,7 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channelutils "github.com/cosmos/ibc-go/v8/modules/core/04-channel/client/utils"
@@ -24,6 +28,12 @@ const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagPacketMemo             = "packet-memo"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagClassIDs               = "class-ids"
+	flagAllowedTokens          = "allowed-tokens"
+	flagAllowedReceivers       = "allowed-receivers"
+	flagMaxTokens              = "max-tokens"
+	flagExpiration             = "expiration"
+	flagRefundAddress          = "refund-address"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
@@ -55,75 +65,256 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				return errors.New("tokenIDs cannot be empty")
 			}
 
-			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			timeoutHeight, timeoutTimestamp, err := parseTimeouts(cmd, clientCtx, srcPort, srcChannel)
 			if err != nil {
 				return err
 			}
-			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+
+			memo, err := cmd.Flags().GetString(flagPacketMemo)
 			if err != nil {
 				return err
 			}
 
-			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			refundAddress, err := cmd.Flags().GetString(flagRefundAddress)
 			if err != nil {
 				return err
 			}
 
-			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+			msg := types.NewMsgTransfer(
+				srcPort, srcChannel, classID, tokenIDs, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+			)
+			msg.RefundAddress = refundAddress
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTransferFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBatchTransferTxCmd returns the command to create a NewMsgBatchTransfer transaction
+func NewBatchTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-transfer [src-port] [src-channel] [receiver] [class-tokens-file]",
+		Short: "Transfer the non-fungible tokens of several classes through IBC",
+		Long: strings.TrimSpace(`Transfer the non-fungible tokens of several classes through IBC to the same receiver,
+one packet being sent for each class. The tokens are read from a JSON file holding an array of classes and their
+tokens, such as [{"class_id":"kitty","token_ids":["kitty1","kitty2"]},{"class_id":"dog","token_ids":["dog1"]}].
+The timeouts are set as for the transfer command.`),
+		Example: fmt.Sprintf("%s tx nft-transfer batch-transfer [src-port] [src-channel] [receiver] class_tokens.json", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
+			sender := clientCtx.GetFromAddress().String()
+			srcPort := args[0]
+			srcChannel := args[1]
+			receiver := args[2]
 
-			memo, err := cmd.Flags().GetString(flagPacketMemo)
+			bz, err := os.ReadFile(args[3])
 			if err != nil {
 				return err
 			}
+			var classTokens []types.ClassTokens
+			if err := json.Unmarshal(bz, &classTokens); err != nil {
+				return fmt.Errorf("invalid class tokens file %s: %w", args[3], err)
+			}
 
-			// if the timeouts are not absolute, retrieve latest block height and block timestamp
-			// for the consensus state connected to the destination port/channel
-			if !absoluteTimeouts {
-				consensusState, height, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
-				if err != nil {
-					return err
-				}
+			timeoutHeight, timeoutTimestamp, err := parseTimeouts(cmd, clientCtx, srcPort, srcChannel)
+			if err != nil {
+				return err
+			}
 
-				if !timeoutHeight.IsZero() {
-					absoluteHeight := height
-					absoluteHeight.RevisionNumber += timeoutHeight.RevisionNumber
-					absoluteHeight.RevisionHeight += timeoutHeight.RevisionHeight
-					timeoutHeight = absoluteHeight
-				}
+			memo, err := cmd.Flags().GetString(flagPacketMemo)
+			if err != nil {
+				return err
+			}
 
-				if timeoutTimestamp != 0 {
-					// use local clock time as reference time if it is later than the
-					// consensus state timestamp of the counter party chain, otherwise
-					// still use consensus state timestamp as reference
-					now := time.Now().UnixNano()
-					consensusStateTimestamp := consensusState.GetTimestamp()
-					if now > 0 {
-						now := uint64(now)
-						if now > consensusStateTimestamp {
-							timeoutTimestamp = now + timeoutTimestamp
-						} else {
-							timeoutTimestamp = consensusStateTimestamp + timeoutTimestamp
-						}
-					} else {
-						return errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
-					}
-				}
+			refundAddress, err := cmd.Flags().GetString(flagRefundAddress)
+			if err != nil {
+				return err
 			}
 
-			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, classID, tokenIDs, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+			msg := types.NewMsgBatchTransfer(
+				srcPort, srcChannel, classTokens, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
 			)
+			msg.RefundAddress = refundAddress
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addTransferFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// addTransferFlags adds the timeout, memo and refund address flags of the
+// transfer commands.
+func addTransferFlags(cmd *cobra.Command) {
 	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().String(flagPacketMemo, "", "Packet memo. Default is empty")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagRefundAddress, "", "Address refunded the tokens if the transfer fails. Default is the sender")
+}
+
+// parseTimeouts returns the timeout height and timestamp of the packets sent
+// over the channel. Unless the "absolute-timeouts" flag is set, the timeouts
+// are relative to the latest consensus state of the counterparty chain.
+func parseTimeouts(cmd *cobra.Command, clientCtx client.Context, srcPort, srcChannel string) (clienttypes.Height, uint64, error) {
+	timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+	timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	// if the timeouts are not absolute, retrieve latest block height and block timestamp
+	// for the consensus state connected to the destination port/channel
+	if !absoluteTimeouts {
+		consensusState, height, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
+		if err != nil {
+			return clienttypes.Height{}, 0, err
+		}
+
+		if !timeoutHeight.IsZero() {
+			absoluteHeight := height
+			absoluteHeight.RevisionNumber += timeoutHeight.RevisionNumber
+			absoluteHeight.RevisionHeight += timeoutHeight.RevisionHeight
+			timeoutHeight = absoluteHeight
+		}
+
+		if timeoutTimestamp != 0 {
+			// use local clock time as reference time if it is later than the
+			// consensus state timestamp of the counter party chain, otherwise
+			// still use consensus state timestamp as reference
+			now := time.Now().UnixNano()
+			consensusStateTimestamp := consensusState.GetTimestamp()
+			if now > 0 {
+				now := uint64(now)
+				if now > consensusStateTimestamp {
+					timeoutTimestamp = now + timeoutTimestamp
+				} else {
+					timeoutTimestamp = consensusStateTimestamp + timeoutTimestamp
+				}
+			} else {
+				return clienttypes.Height{}, 0, errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+			}
+		}
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil
+}
+
+// NewGrantTransferAuthorizationCmd returns the command to grant a TransferAuthorization
+func NewGrantTransferAuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [src-port] [src-channel]",
+		Short: "Grant an authorization to transfer non-fungible tokens through IBC on behalf of the granter",
+		Long: strings.TrimSpace(`Grant an authorization to transfer non-fungible tokens through IBC over the given
+port and channel on behalf of the granter. The classes, the tokens and the receivers allowed can be restricted
+using the "class-ids", "allowed-tokens" and "allowed-receivers" flags, the allowed tokens of a class being given
+in the form {classID}={tokenID},{tokenID}. Each allowed token may be transferred at most once. The number of tokens
+which may be transferred can be limited using the "max-tokens" flag.`),
+		Example: fmt.Sprintf(
+			"%s tx nft-transfer grant [grantee] nft-transfer channel-0 --allowed-tokens kitty=kitty1,kitty2 --max-tokens 2 --from [granter]",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			classIDs, err := cmd.Flags().GetStringSlice(flagClassIDs)
+			if err != nil {
+				return err
+			}
+
+			allowedTokensStrs, err := cmd.Flags().GetStringArray(flagAllowedTokens)
+			if err != nil {
+				return err
+			}
+			allowedTokens := make([]types.AllowedTokens, 0, len(allowedTokensStrs))
+			for _, allowedTokensStr := range allowedTokensStrs {
+				classID, tokenIDs, found := strings.Cut(allowedTokensStr, "=")
+				if !found {
+					return fmt.Errorf("invalid allowed tokens %s, expected {classID}={tokenID},{tokenID}", allowedTokensStr)
+				}
+				allowedTokens = append(allowedTokens, types.AllowedTokens{
+					ClassId:  classID,
+					TokenIds: strings.Split(tokenIDs, ","),
+				})
+			}
+
+			allowedReceivers, err := cmd.Flags().GetStringSlice(flagAllowedReceivers)
+			if err != nil {
+				return err
+			}
+
+			maxTokens, err := cmd.Flags().GetUint64(flagMaxTokens)
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewTransferAuthorization(types.Allocation{
+				SourcePort:       args[1],
+				SourceChannel:    args[2],
+				ClassIds:         classIDs,
+				AllowedTokens:    allowedTokens,
+				AllowedReceivers: allowedReceivers,
+				MaxTokens:        maxTokens,
+			})
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(flagExpiration)
+			if err != nil {
+				return err
+			}
+			var expiration *time.Time
+			if exp != 0 {
+				expirationTime := time.Unix(exp, 0)
+				expiration = &expirationTime
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagClassIDs, []string{}, "Comma-separated classes whose tokens may be transferred. Any class is allowed if empty")
+	cmd.Flags().StringArray(flagAllowedTokens, []string{}, "Tokens of a class which may be transferred in the form {classID}={tokenID},{tokenID}. Can be repeated. Any token is allowed if empty")
+	cmd.Flags().StringSlice(flagAllowedReceivers, []string{}, "Comma-separated receivers the tokens may be transferred to. Any receiver is allowed if empty")
+	cmd.Flags().Uint64(flagMaxTokens, 0, "Maximum number of tokens which may be transferred. There is no limit when set to 0")
+	cmd.Flags().Int64(flagExpiration, 0, "Expiration time of the grant as a unix timestamp. The grant does not expire when set to 0")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -19,7 +19,9 @@ import (
 )
 
 var (
-	_ porttypes.IBCModule = IBCModule{}
+	_ porttypes.IBCModule             = IBCModule{}
+	_ porttypes.PacketDataUnmarshaler = IBCModule{}
+	_ porttypes.UpgradableModule      = IBCModule{}
 )
 
 // IBCModule implements the ICS26 interface for transfer given the transfer keeper.
@@ -85,8 +87,10 @@ func (im IBCModule) OnChanOpenInit(
 		version = types.Version
 	}
 
-	if version != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	// when stacked under the fee middleware, the fee-wrapped version is unwrapped
+	// before reaching the module, which only sees the nft-transfer version
+	if !types.IsSupportedVersion(version) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "got %s, expected one of %s", version, types.SupportedVersions)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -112,8 +116,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected one of %s", counterpartyVersion, types.SupportedVersions)
 	}
 
 	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
@@ -127,7 +131,7 @@ func (im IBCModule) OnChanOpenTry(
 		}
 	}
 
-	return types.Version, nil
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -138,8 +142,8 @@ func (im IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected one of %s", counterpartyVersion, types.SupportedVersions)
 	}
 	im.keeper.SetEscrowAddress(ctx, portID, channelID)
 	return nil
@@ -174,6 +178,71 @@ func (im IBCModule) OnChanCloseConfirm(
 	return nil
 }
 
+// OnChanUpgradeInit implements the UpgradableModule interface
+func (im IBCModule) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) (string, error) {
+	if err := ValidateTransferChannelParams(ctx, im.keeper, proposedOrder, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if !types.IsSupportedVersion(proposedVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "got %s, expected one of %s", proposedVersion, types.SupportedVersions)
+	}
+
+	return proposedVersion, nil
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface
+func (im IBCModule) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	if err := ValidateTransferChannelParams(ctx, im.keeper, proposedOrder, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected one of %s", counterpartyVersion, types.SupportedVersions)
+	}
+
+	return counterpartyVersion, nil
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface
+func (IBCModule) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyVersion string,
+) error {
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected one of %s", counterpartyVersion, types.SupportedVersions)
+	}
+	return nil
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface. The escrow address
+// of the channel is kept across upgrades, so there is nothing to migrate.
+func (IBCModule) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) {
+}
+
 // OnRecvPacket implements the IBCModule interface. A successful acknowledgement
 // is returned if the packet data is successfully decoded and the receive application
 // logic returns without error.
@@ -183,7 +252,7 @@ func (im IBCModule) OnRecvPacket(
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
 	var (
-		ack    = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+		ack    = channeltypes.NewResultAcknowledgement(types.LegacyPacketResult)
 		data   types.NonFungibleTokenPacketData
 		ackErr error
 	)
@@ -198,12 +267,18 @@ func (im IBCModule) OnRecvPacket(
 	// only attempt the application logic if the packet data
 	// was successfully decoded
 	if ack.Success() {
-		if err := im.keeper.OnRecvPacket(ctx, packet, data); err != nil {
+		result, err := im.keeper.OnRecvPacket(ctx, packet, data)
+		if err != nil {
 			ack = channeltypes.NewErrorAcknowledgement(err)
 			ackErr = err
+		} else {
+			ack = result.Acknowledgement()
 		}
 	}
 	keeper.EmitAcknowledgementEvent(ctx, data, ack, ackErr)
+	if ackErr != nil {
+		keeper.ReportErrorAcknowledgement(packet, ackErr)
+	}
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
 	return ack
 }
@@ -245,10 +320,22 @@ func (im IBCModule) OnAcknowledgementPacket(
 
 	switch resp := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Result:
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, string(resp.Result)),
+		}
+		// the result is informative only, the legacy result of older
+		// counterparties and an undecodable result are not rejected
+		if result, legacy, err := types.ParsePacketResult(resp.Result); err == nil && !legacy {
+			attributes = append(attributes,
+				sdk.NewAttribute(types.AttributeKeyAckClassID, result.ClassId),
+				sdk.NewAttribute(types.AttributeKeyAckTraceHash, result.ClassTraceHash),
+				sdk.NewAttribute(types.AttributeKeyAckReceiver, result.Receiver),
+			)
+		}
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypePacket,
-				sdk.NewAttribute(types.AttributeKeyAckSuccess, string(resp.Result)),
+				attributes...,
 			),
 		)
 	case *channeltypes.Acknowledgement_Error:
@@ -291,3 +378,15 @@ func (im IBCModule) OnTimeoutPacket(
 
 	return nil
 }
+
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes
+// into a NonFungibleTokenPacketData. This function implements the optional
+// PacketDataUnmarshaler interface required for ADR 008 support.
+func (IBCModule) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	var packetData types.NonFungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+		return nil, err
+	}
+
+	return packetData, nil
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	nfttransfer "github.com/bianjieai/nft-transfer"
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestPacketResult tests that the success acknowledgement carries the class of
+// the tokens on the receiving chain, and that the result is emitted in events
+// on acknowledgement while the legacy result is still accepted.
+func (suite *KeeperTestSuite) TestPacketResult() {
+	var (
+		path    *ibctesting.Path
+		classID = "cryptoCat"
+		sender  string
+		result  types.NonFungibleTokenPacketResult
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func() (packet channeltypes.Packet, ack []byte)
+		expAttrs bool
+	}{
+		{
+			"success: vouchers minted",
+			func() (channeltypes.Packet, []byte) {
+				packet, ack := suite.transferAndReceive(path.EndpointA, classID, sender)
+				classTrace := types.ParseClassTrace(types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + classID)
+				result = types.NewNonFungibleTokenPacketResult(classTrace.IBCClassID(), classTrace.Hash().String(), suite.chainB.SenderAccount.GetAddress().String())
+				return packet, ack
+			},
+			true,
+		},
+		{
+			"success: tokens returned to their chain of origin",
+			func() (channeltypes.Packet, []byte) {
+				packet, ack := suite.transferAndReceive(path.EndpointA, classID, sender)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ack))
+
+				voucherClassID := types.ParseClassTrace(types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + classID).IBCClassID()
+				packet, ack = suite.transferAndReceive(path.EndpointB, voucherClassID, suite.chainB.SenderAccount.GetAddress().String())
+				result = types.NewNonFungibleTokenPacketResult(classID, "", sender)
+				return packet, ack
+			},
+			true,
+		},
+		{
+			"success: legacy result accepted",
+			func() (channeltypes.Packet, []byte) {
+				packet, _ := suite.transferAndReceive(path.EndpointA, classID, sender)
+				return packet, channeltypes.NewResultAcknowledgement(types.LegacyPacketResult).Acknowledgement()
+			},
+			false,
+		},
+		{
+			"success: undecodable result accepted",
+			func() (channeltypes.Packet, []byte) {
+				packet, _ := suite.transferAndReceive(path.EndpointA, classID, sender)
+				return packet, channeltypes.NewResultAcknowledgement([]byte("ok")).Acknowledgement()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs(classID, "kitty1")
+			sender = suite.chainA.SenderAccount.GetAddress().String()
+
+			packet, ack := tc.malleate()
+			if tc.expAttrs {
+				suite.Require().Equal(result.Acknowledgement().Acknowledgement(), ack)
+			}
+
+			// the packet is acknowledged on the chain it was sent from
+			endpoint := path.EndpointA
+			if packet.GetSourceChannel() == path.EndpointB.ChannelID {
+				endpoint = path.EndpointB
+			}
+			app := endpoint.Chain.GetSimApp()
+			ctx := endpoint.Chain.GetContext()
+			module := nfttransfer.NewIBCModule(app.NFTTransferKeeper)
+			suite.Require().NoError(module.OnAcknowledgementPacket(ctx, packet, ack, endpoint.Chain.SenderAccount.GetAddress()))
+
+			attrs := ackSuccessAttributes(ctx.EventManager().Events())
+			if !tc.expAttrs {
+				suite.Require().NotContains(attrs, types.AttributeKeyAckClassID)
+				return
+			}
+			suite.Require().Equal(result.ClassId, attrs[types.AttributeKeyAckClassID])
+			suite.Require().Equal(result.ClassTraceHash, attrs[types.AttributeKeyAckTraceHash])
+			suite.Require().Equal(result.Receiver, attrs[types.AttributeKeyAckReceiver])
+		})
+	}
+}
+
+// transferAndReceive sends the kitty1 token of the class from the chain of the
+// endpoint to the sender account of the counterparty chain, receives it on the
+// counterparty chain and returns the packet and the acknowledgement written.
+func (suite *KeeperTestSuite) transferAndReceive(endpoint *ibctesting.Endpoint, classID, sender string) (channeltypes.Packet, []byte) {
+	msg := types.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		classID,
+		[]string{"kitty1"},
+		sender,
+		endpoint.Counterparty.Chain.SenderAccount.GetAddress().String(),
+		endpoint.Counterparty.Chain.GetTimeoutHeight(),
+		0,
+		"",
+	)
+	res, err := endpoint.Chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(endpoint.Counterparty.UpdateClient())
+	res, err = endpoint.Counterparty.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return packet, ack
+}
+
+// ackSuccessAttributes returns the attributes of the packet event emitted for a
+// success acknowledgement.
+func ackSuccessAttributes(events sdk.Events) map[string]string {
+	attrs := make(map[string]string)
+	for _, event := range events {
+		if event.Type != types.EventTypePacket {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+	}
+	return attrs
+}
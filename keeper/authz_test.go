@@ -0,0 +1,283 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestTransferAuthorization tests that a grantee may only transfer the tokens
+// of the granter allowed by a TransferAuthorization through x/authz exec.
+func (suite *KeeperTestSuite) TestTransferAuthorization() {
+	var (
+		path          *ibctesting.Path
+		allocation    types.Allocation
+		refundAddress string
+		classID       = "cryptoCat"
+	)
+
+	testCases := []struct {
+		msg              string
+		malleate         func()
+		tokenIDs         []string
+		expPass          bool
+		expAuthorization func() authz.Authorization
+	}{
+		{
+			"success: allowed tokens consumed",
+			func() {},
+			[]string{"kitty1"},
+			true,
+			func() authz.Authorization {
+				allocation.AllowedTokens = []types.AllowedTokens{{ClassId: classID, TokenIds: []string{"kitty2"}}}
+				allocation.MaxTokens = 1
+				return types.NewTransferAuthorization(allocation)
+			},
+		},
+		{
+			"success: grant deleted once exhausted",
+			func() {},
+			[]string{"kitty1", "kitty2"},
+			true,
+			func() authz.Authorization { return nil },
+		},
+		{
+			"token not allowed",
+			func() {
+				allocation.AllowedTokens = []types.AllowedTokens{{ClassId: classID, TokenIds: []string{"kitty2"}}}
+			},
+			[]string{"kitty1"},
+			false,
+			func() authz.Authorization { return types.NewTransferAuthorization(allocation) },
+		},
+		{
+			"receiver not allowed",
+			func() {
+				allocation.AllowedReceivers = []string{suite.chainA.SenderAccount.GetAddress().String()}
+			},
+			[]string{"kitty1"},
+			false,
+			func() authz.Authorization { return types.NewTransferAuthorization(allocation) },
+		},
+		{
+			"success: refund to the granter",
+			func() {
+				refundAddress = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			[]string{"kitty1", "kitty2"},
+			true,
+			func() authz.Authorization { return nil },
+		},
+		{
+			"refund to the grantee",
+			func() {
+				refundAddress = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			[]string{"kitty1"},
+			false,
+			func() authz.Authorization { return types.NewTransferAuthorization(allocation) },
+		},
+		{
+			"channel not allowed",
+			func() {
+				allocation.SourceChannel = "channel-100"
+			},
+			[]string{"kitty1"},
+			false,
+			func() authz.Authorization { return types.NewTransferAuthorization(allocation) },
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs(classID, "kitty1", "kitty2")
+
+			granter := suite.chainA.SenderAccount.GetAddress()
+			grantee := suite.chainA.SenderAccounts[1]
+			receiver := suite.chainB.SenderAccount.GetAddress().String()
+
+			allocation = types.Allocation{
+				SourcePort:       path.EndpointA.ChannelConfig.PortID,
+				SourceChannel:    path.EndpointA.ChannelID,
+				ClassIds:         []string{classID},
+				AllowedTokens:    []types.AllowedTokens{{ClassId: classID, TokenIds: []string{"kitty1", "kitty2"}}},
+				AllowedReceivers: []string{receiver},
+				MaxTokens:        2,
+			}
+			refundAddress = ""
+			tc.malleate()
+
+			msgGrant, err := authz.NewMsgGrant(granter, grantee.SenderAccount.GetAddress(), types.NewTransferAuthorization(allocation), nil)
+			suite.Require().NoError(err)
+			_, err = suite.chainA.SendMsgs(msgGrant)
+			suite.Require().NoError(err)
+
+			msgTransfer := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				classID,
+				tc.tokenIDs,
+				granter.String(),
+				receiver,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+				"",
+			)
+			msgTransfer.RefundAddress = refundAddress
+			msgExec := authz.NewMsgExec(grantee.SenderAccount.GetAddress(), []sdk.Msg{msgTransfer})
+
+			// the grantee signs the exec
+			granterAccount := ibctesting.SenderAccount{
+				SenderPrivKey: suite.chainA.SenderPrivKey,
+				SenderAccount: suite.chainA.SenderAccount,
+			}
+			suite.chainA.SenderPrivKey, suite.chainA.SenderAccount = grantee.SenderPrivKey, grantee.SenderAccount
+			res, err := suite.chainA.SendMsgs(&msgExec)
+			suite.chainA.SenderPrivKey, suite.chainA.SenderAccount = granterAccount.SenderPrivKey, granterAccount.SenderAccount
+
+			ctx := suite.chainA.GetContext()
+			nftKeeper := suite.GetSimApp(suite.chainA).NFTKeeper
+			expAuthorization := tc.expAuthorization()
+			authorization, _ := suite.GetSimApp(suite.chainA).AuthzKeeper.GetAuthorization(
+				ctx, grantee.SenderAccount.GetAddress(), granter, sdk.MsgTypeURL(&types.MsgTransfer{}),
+			)
+			if expAuthorization == nil {
+				suite.Require().Nil(authorization)
+			} else {
+				suite.Require().Equal(expAuthorization, authorization)
+			}
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				for _, tokenID := range tc.tokenIDs {
+					suite.Require().Equal(granter, nftKeeper.GetOwner(ctx, classID, tokenID))
+				}
+				return
+			}
+
+			suite.Require().NoError(err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.Require().NoError(path.RelayPacket(packet))
+
+			voucherClassID := types.ParseClassTrace(
+				types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + classID,
+			).IBCClassID()
+			for _, tokenID := range tc.tokenIDs {
+				suite.Require().Equal(
+					suite.chainB.SenderAccount.GetAddress(),
+					suite.GetSimApp(suite.chainB).NFTKeeper.GetOwner(suite.chainB.GetContext(), voucherClassID, tokenID),
+				)
+			}
+		})
+	}
+}
+
+// TestBatchTransferAuthorization tests that a grantee may only execute a batch
+// transfer of the granter with a GenericAuthorization, a TransferAuthorization
+// allocating the tokens of the batch not granting it.
+func (suite *KeeperTestSuite) TestBatchTransferAuthorization() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg           string
+		authorization func() authz.Authorization
+		expPass       bool
+	}{
+		{
+			"success: generic authorization",
+			func() authz.Authorization {
+				return authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgBatchTransfer{}))
+			},
+			true,
+		},
+		{
+			"transfer authorization",
+			func() authz.Authorization {
+				return types.NewTransferAuthorization(types.Allocation{
+					SourcePort:    path.EndpointA.ChannelConfig.PortID,
+					SourceChannel: path.EndpointA.ChannelID,
+					AllowedTokens: []types.AllowedTokens{
+						{ClassId: "cryptoCat", TokenIds: []string{"kitty1"}},
+						{ClassId: "cryptoDog", TokenIds: []string{"puppy"}},
+					},
+				})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs("cryptoCat", "kitty1")
+			suite.mintNFTs("cryptoDog", "puppy")
+
+			granter := suite.chainA.SenderAccount.GetAddress()
+			grantee := suite.chainA.SenderAccounts[1]
+
+			msgGrant, err := authz.NewMsgGrant(granter, grantee.SenderAccount.GetAddress(), tc.authorization(), nil)
+			suite.Require().NoError(err)
+			_, err = suite.chainA.SendMsgs(msgGrant)
+			suite.Require().NoError(err)
+
+			msgBatchTransfer := types.NewMsgBatchTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				[]types.ClassTokens{
+					{ClassId: "cryptoCat", TokenIds: []string{"kitty1"}},
+					{ClassId: "cryptoDog", TokenIds: []string{"puppy"}},
+				},
+				granter.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(),
+				0,
+				"",
+			)
+			msgExec := authz.NewMsgExec(grantee.SenderAccount.GetAddress(), []sdk.Msg{msgBatchTransfer})
+
+			// the grantee signs the exec
+			granterAccount := ibctesting.SenderAccount{
+				SenderPrivKey: suite.chainA.SenderPrivKey,
+				SenderAccount: suite.chainA.SenderAccount,
+			}
+			suite.chainA.SenderPrivKey, suite.chainA.SenderAccount = grantee.SenderPrivKey, grantee.SenderAccount
+			res, err := suite.chainA.SendMsgs(&msgExec)
+			suite.chainA.SenderPrivKey, suite.chainA.SenderAccount = granterAccount.SenderPrivKey, granterAccount.SenderAccount
+
+			ctx := suite.chainA.GetContext()
+			nftKeeper := suite.GetSimApp(suite.chainA).NFTKeeper
+			if !tc.expPass {
+				suite.Require().ErrorContains(err, "authorization not found")
+				for _, classTokens := range msgBatchTransfer.ClassTokens {
+					suite.Require().Equal(granter, nftKeeper.GetOwner(ctx, classTokens.ClassId, classTokens.TokenIds[0]))
+				}
+				return
+			}
+
+			suite.Require().NoError(err)
+			packets, err := ibctesting.ParsePacketsFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.Require().Len(packets, len(msgBatchTransfer.ClassTokens))
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			for _, classTokens := range msgBatchTransfer.ClassTokens {
+				suite.Require().Equal(escrowAddress, nftKeeper.GetOwner(ctx, classTokens.ClassId, classTokens.TokenIds[0]))
+			}
+		})
+	}
+}
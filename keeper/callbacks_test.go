@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/x/nft"
+	callbacktypes "github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/testing/mock"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestCallbacks tests that the ibc-callbacks middleware executes the source and
+// destination callbacks defined in the memo of an nft-transfer packet.
+func (suite *KeeperTestSuite) TestCallbacks() {
+	var (
+		classID = "cryptoCat"
+		nftID   = "kitty"
+		memo    string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		timeout  bool
+		expSend  bool
+		// expected callbacks executed on the source chain and the destination chain
+		expSrc  map[callbacktypes.CallbackType]int
+		expDest map[callbacktypes.CallbackType]int
+	}{
+		{
+			"success: source callback on send and acknowledgement", func() {
+				memo = fmt.Sprintf(`{"src_callback":{"address":%q}}`, mock.SuccessContract)
+			}, false, true,
+			map[callbacktypes.CallbackType]int{
+				callbacktypes.CallbackTypeSendPacket:            1,
+				callbacktypes.CallbackTypeAcknowledgementPacket: 1,
+			},
+			map[callbacktypes.CallbackType]int{},
+		},
+		{
+			"success: source callback on send and timeout", func() {
+				memo = fmt.Sprintf(`{"src_callback":{"address":%q}}`, mock.SuccessContract)
+			}, true, true,
+			map[callbacktypes.CallbackType]int{
+				callbacktypes.CallbackTypeSendPacket:    1,
+				callbacktypes.CallbackTypeTimeoutPacket: 1,
+			},
+			map[callbacktypes.CallbackType]int{},
+		},
+		{
+			"success: destination callback on receive", func() {
+				memo = fmt.Sprintf(`{"dest_callback":{"address":%q}}`, mock.SuccessContract)
+			}, false, true,
+			map[callbacktypes.CallbackType]int{},
+			map[callbacktypes.CallbackType]int{
+				callbacktypes.CallbackTypeReceivePacket: 1,
+			},
+		},
+		{
+			"success: failed destination callback does not block the packet", func() {
+				memo = fmt.Sprintf(`{"dest_callback":{"address":%q}}`, mock.ErrorContract)
+			}, false, true,
+			map[callbacktypes.CallbackType]int{},
+			map[callbacktypes.CallbackType]int{
+				callbacktypes.CallbackTypeReceivePacket: 1,
+			},
+		},
+		{
+			"success: no callback in memo", func() {
+				memo = "memo"
+			}, false, true,
+			map[callbacktypes.CallbackType]int{},
+			map[callbacktypes.CallbackType]int{},
+		},
+		{
+			"failure: source callback rejects the send", func() {
+				memo = fmt.Sprintf(`{"src_callback":{"address":%q}}`, mock.ErrorContract)
+			}, false, false,
+			map[callbacktypes.CallbackType]int{
+				callbacktypes.CallbackTypeSendPacket: 1,
+			},
+			map[callbacktypes.CallbackType]int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			nftKeeper := suite.GetSimApp(suite.chainA).NFTKeeper
+			err := nftKeeper.SaveClass(suite.chainA.GetContext(), nft.Class{
+				Id:   classID,
+				Data: suite.classMetadata,
+			})
+			suite.Require().NoError(err, "SaveClass error")
+			err = nftKeeper.Mint(suite.chainA.GetContext(), nft.NFT{
+				ClassId: classID,
+				Id:      nftID,
+				Data:    suite.tokenMetadata,
+			}, sender)
+			suite.Require().NoError(err, "Mint error")
+
+			tc.malleate()
+
+			timeoutHeight := suite.chainB.GetTimeoutHeight()
+			timeoutTimestamp := uint64(0)
+			if tc.timeout {
+				timeoutHeight = clienttypes.ZeroHeight()
+				timeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Second).UnixNano())
+			}
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				classID,
+				[]string{nftID},
+				sender.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				timeoutHeight,
+				timeoutTimestamp,
+				memo,
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+
+			contractKeeperA := suite.GetSimApp(suite.chainA).MockContractKeeper
+			contractKeeperB := suite.GetSimApp(suite.chainB).MockContractKeeper
+			if !tc.expSend {
+				suite.Require().Error(err)
+				suite.Require().Equal(sender, nftKeeper.GetOwner(suite.chainA.GetContext(), classID, nftID))
+				suite.Require().Equal(tc.expSrc, contractKeeperA.Counters)
+				return
+			}
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			if tc.timeout {
+				suite.coordinator.IncrementTimeBy(time.Minute)
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+				suite.Require().Equal(sender, nftKeeper.GetOwner(suite.chainA.GetContext(), classID, nftID))
+			} else {
+				_, ack, err := path.RelayPacketWithResults(packet)
+				suite.Require().NoError(err)
+				suite.Require().Equal(suite.successAck(path, classID), ack)
+			}
+
+			suite.Require().Equal(tc.expSrc, contractKeeperA.Counters)
+			suite.Require().Equal(tc.expDest, contractKeeperB.Counters)
+			for callbackType := range tc.expSrc {
+				suite.Require().Equal(packet.GetData(), contractKeeperA.Packets[callbackType])
+			}
+			for callbackType := range tc.expDest {
+				suite.Require().Equal(packet.GetData(), contractKeeperB.Packets[callbackType])
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// escrowToken transfers the token to the escrow account of the given port and
+// channel and records it in the escrow index.
+func (k Keeper) escrowToken(ctx sdk.Context, portID, channelID, classID, tokenID, tokenData string) error {
+	escrowAddress := types.GetEscrowAddress(portID, channelID)
+	if err := k.nftKeeper.Transfer(ctx, classID, tokenID, tokenData, escrowAddress); err != nil {
+		return err
+	}
+	k.SetEscrowedToken(ctx, types.NewEscrowedToken(portID, channelID, classID, tokenID))
+	return nil
+}
+
+// unescrowToken transfers the token from the escrow account of the given port
+// and channel to the receiver and removes it from the escrow index.
+func (k Keeper) unescrowToken(ctx sdk.Context, portID, channelID, classID, tokenID, tokenData string, receiver sdk.AccAddress) error {
+	if err := k.nftKeeper.Transfer(ctx, classID, tokenID, tokenData, receiver); err != nil {
+		return err
+	}
+	k.DeleteEscrowedToken(ctx, portID, channelID, classID, tokenID)
+	return nil
+}
+
+// escrowedTokenKey is the key of a token in the escrow index: the port and
+// channel of the escrow account, and the class and id of the token.
+type escrowedTokenKey = collections.Triple[string, string, collections.Pair[string, string]]
+
+// escrowedTokensPrefix returns the key prefix of the tokens escrowed by the
+// given port and channel. If classID is not empty, the prefix is restricted to
+// the tokens of the class.
+func escrowedTokensPrefix(portID, channelID, classID string) escrowedTokenKey {
+	if classID == "" {
+		return collections.TripleSuperPrefix[string, string, collections.Pair[string, string]](portID, channelID)
+	}
+	return collections.Join3(portID, channelID, collections.PairPrefix[string, string](classID))
+}
+
+// GetTokenEscrow returns the escrow entry of the given token, if it is held by
+// the escrow account of any port and channel.
+func (k Keeper) GetTokenEscrow(ctx sdk.Context, classID, tokenID string) (types.EscrowedToken, bool) {
+	token, err := k.tokenEscrows.Get(ctx, collections.Join(classID, tokenID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.EscrowedToken{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return token, true
+}
+
+// SetEscrowedToken records the token in the escrow index of its port and
+// channel and increments the escrowed count of its class.
+func (k Keeper) SetEscrowedToken(ctx sdk.Context, token types.EscrowedToken) {
+	if existing, found := k.GetTokenEscrow(ctx, token.ClassId, token.TokenId); found {
+		k.DeleteEscrowedToken(ctx, existing.PortId, existing.ChannelId, existing.ClassId, existing.TokenId)
+	}
+
+	key := collections.Join3(token.PortId, token.ChannelId, collections.Join(token.ClassId, token.TokenId))
+	if err := k.escrowedTokens.Set(ctx, key, token); err != nil {
+		panic(err)
+	}
+	if err := k.tokenEscrows.Set(ctx, collections.Join(token.ClassId, token.TokenId), token); err != nil {
+		panic(err)
+	}
+
+	count := k.GetEscrowedClassCount(ctx, token.PortId, token.ChannelId, token.ClassId)
+	k.setEscrowedClassCount(ctx, token.PortId, token.ChannelId, token.ClassId, count+1)
+}
+
+// DeleteEscrowedToken removes the token from the escrow index of the given port
+// and channel and decrements the escrowed count of its class.
+func (k Keeper) DeleteEscrowedToken(ctx sdk.Context, portID, channelID, classID, tokenID string) {
+	key := collections.Join3(portID, channelID, collections.Join(classID, tokenID))
+	found, err := k.escrowedTokens.Has(ctx, key)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return
+	}
+	if err := k.escrowedTokens.Remove(ctx, key); err != nil {
+		panic(err)
+	}
+	if err := k.tokenEscrows.Remove(ctx, collections.Join(classID, tokenID)); err != nil {
+		panic(err)
+	}
+
+	count := k.GetEscrowedClassCount(ctx, portID, channelID, classID)
+	k.setEscrowedClassCount(ctx, portID, channelID, classID, count-1)
+}
+
+// GetAllEscrowedTokens returns all the escrowed tokens.
+func (k Keeper) GetAllEscrowedTokens(ctx sdk.Context) []types.EscrowedToken {
+	tokens := []types.EscrowedToken{}
+	k.IterateEscrowedTokens(ctx, func(token types.EscrowedToken) bool {
+		tokens = append(tokens, token)
+		return false
+	})
+	return tokens
+}
+
+// IterateEscrowedTokens iterates over the escrowed tokens in the store
+// and performs a callback function.
+func (k Keeper) IterateEscrowedTokens(ctx sdk.Context, cb func(token types.EscrowedToken) bool) {
+	err := k.escrowedTokens.Walk(ctx, nil, func(_ escrowedTokenKey, token types.EscrowedToken) (bool, error) {
+		return cb(token), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetEscrowedClassCount returns the number of tokens of the given class held by
+// the escrow account of the given port and channel.
+func (k Keeper) GetEscrowedClassCount(ctx sdk.Context, portID, channelID, classID string) uint64 {
+	classCount, err := k.escrowedClassCounts.Get(ctx, collections.Join3(portID, channelID, classID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0
+	}
+	if err != nil {
+		panic(err)
+	}
+	return classCount.Count
+}
+
+// IterateEscrowedClassCounts iterates over the escrowed class counts in the store
+// and performs a callback function.
+func (k Keeper) IterateEscrowedClassCounts(ctx sdk.Context, cb func(classCount types.EscrowedClassCount) bool) {
+	err := k.escrowedClassCounts.Walk(ctx, nil, func(_ collections.Triple[string, string, string], classCount types.EscrowedClassCount) (bool, error) {
+		return cb(classCount), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) setEscrowedClassCount(ctx sdk.Context, portID, channelID, classID string, count uint64) {
+	key := collections.Join3(portID, channelID, classID)
+	if count == 0 {
+		if err := k.escrowedClassCounts.Remove(ctx, key); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	if err := k.escrowedClassCounts.Set(ctx, key, types.NewEscrowedClassCount(portID, channelID, classID, count)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/bianjieai/nft-transfer/adapter"
+	"github.com/bianjieai/nft-transfer/keeper"
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/testing/simapp"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestEscrowIndex tests that the escrow index follows the tokens escrowed on
+// send, unescrowed on receive and unescrowed on refund.
+func (suite *KeeperTestSuite) TestEscrowIndex() {
+	suite.SetupTest() // reset
+
+	classID := "cryptoCat"
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	suite.mintNFTs(classID, "kitty1", "kitty2", "kitty3")
+
+	keeperA := suite.GetSimApp(suite.chainA).NFTTransferKeeper
+	portA, channelA := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+
+	send := func(chain *ibctesting.TestChain, endpoint *ibctesting.Endpoint, counterparty *ibctesting.TestChain,
+		classID string, timeoutTimestamp uint64, nftIDs ...string) channeltypes.Packet {
+		timeoutHeight := counterparty.GetTimeoutHeight()
+		if timeoutTimestamp != 0 {
+			timeoutHeight = clienttypes.ZeroHeight()
+		}
+		msg := types.NewMsgTransfer(
+			endpoint.ChannelConfig.PortID,
+			endpoint.ChannelID,
+			classID,
+			nftIDs,
+			chain.SenderAccount.GetAddress().String(),
+			counterparty.SenderAccount.GetAddress().String(),
+			timeoutHeight,
+			timeoutTimestamp,
+			"",
+		)
+		res, err := chain.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		suite.Require().NoError(err)
+		return packet
+	}
+
+	// tokens are indexed when escrowed on send
+	packet := send(suite.chainA, path.EndpointA, suite.chainB, classID, 0, "kitty1", "kitty2")
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().ElementsMatch([]types.EscrowedToken{
+		types.NewEscrowedToken(portA, channelA, classID, "kitty1"),
+		types.NewEscrowedToken(portA, channelA, classID, "kitty2"),
+	}, keeperA.GetAllEscrowedTokens(ctx))
+	suite.Require().Equal(uint64(2), keeperA.GetEscrowedClassCount(ctx, portA, channelA, classID))
+
+	tokensRes, err := suite.queryClient.EscrowedTokens(ctx, &types.QueryEscrowedTokensRequest{
+		PortId: portA, ChannelId: channelA, ClassId: classID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(tokensRes.EscrowedTokens, 2)
+
+	countsRes, err := suite.queryClient.EscrowedClassCounts(ctx, &types.QueryEscrowedClassCountsRequest{
+		PortId: portA, ChannelId: channelA,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.EscrowedClassCount{types.NewEscrowedClassCount(portA, channelA, classID, 2)}, countsRes.ClassCounts)
+
+	escrowRes, err := suite.queryClient.TokenEscrow(ctx, &types.QueryTokenEscrowRequest{ClassId: classID, TokenId: "kitty1"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewEscrowedToken(portA, channelA, classID, "kitty1"), escrowRes.EscrowedToken)
+
+	// tokens are removed from the index when unescrowed on receive
+	voucherClassID := types.ParseClassTrace(
+		types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + classID,
+	).IBCClassID()
+	packet = send(suite.chainB, path.EndpointB, suite.chainA, voucherClassID, 0, "kitty1")
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	ctx = suite.chainA.GetContext()
+	suite.Require().Equal(uint64(1), keeperA.GetEscrowedClassCount(ctx, portA, channelA, classID))
+	_, err = suite.queryClient.TokenEscrow(ctx, &types.QueryTokenEscrowRequest{ClassId: classID, TokenId: "kitty1"})
+	suite.Require().Error(err)
+
+	// tokens are removed from the index when refunded
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Second).UnixNano())
+	packet = send(suite.chainA, path.EndpointA, suite.chainB, classID, timeoutTimestamp, "kitty3")
+	suite.Require().Equal(uint64(2), keeperA.GetEscrowedClassCount(suite.chainA.GetContext(), portA, channelA, classID))
+
+	suite.coordinator.IncrementTimeBy(time.Minute)
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+	ctx = suite.chainA.GetContext()
+	suite.Require().Equal([]types.EscrowedToken{
+		types.NewEscrowedToken(portA, channelA, classID, "kitty2"),
+	}, keeperA.GetAllEscrowedTokens(ctx))
+	suite.Require().Equal(uint64(1), keeperA.GetEscrowedClassCount(ctx, portA, channelA, classID))
+}
+
+// TestMigrate1to2 tests that the migration builds the escrow index from the
+// tokens held by the escrow accounts.
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	suite.SetupTest() // reset
+
+	classID := "cryptoCat"
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	suite.mintNFTs(classID, "kitty1", "kitty2", "kitty3")
+
+	portA, channelA := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	nftTransferKeeper := suite.GetSimApp(suite.chainA).NFTTransferKeeper
+	nftKeeper := suite.GetSimApp(suite.chainA).NFTKeeper
+	ctx := suite.chainA.GetContext()
+
+	// escrow tokens without going through the index, as done before the migration
+	escrowAddress := types.GetEscrowAddress(portA, channelA)
+	suite.Require().NoError(nftKeeper.Transfer(ctx, classID, "kitty1", escrowAddress))
+	suite.Require().NoError(nftKeeper.Transfer(ctx, classID, "kitty2", escrowAddress))
+	suite.Require().Empty(nftTransferKeeper.GetAllEscrowedTokens(ctx))
+
+	err := keeper.NewMigrator(nftTransferKeeper).Migrate1to2(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().ElementsMatch([]types.EscrowedToken{
+		types.NewEscrowedToken(portA, channelA, classID, "kitty1"),
+		types.NewEscrowedToken(portA, channelA, classID, "kitty2"),
+	}, nftTransferKeeper.GetAllEscrowedTokens(ctx))
+	suite.Require().Equal(uint64(2), nftTransferKeeper.GetEscrowedClassCount(ctx, portA, channelA, classID))
+}
+
+// nftKeeperWithoutIterator hides the NFTIterator implementation of an NFTKeeper.
+type nftKeeperWithoutIterator struct {
+	types.NFTKeeper
+}
+
+// newKeeperWithoutIterator returns an nft-transfer keeper over the store of the
+// app whose NFTKeeper does not implement the NFTIterator interface.
+func newKeeperWithoutIterator(app *simapp.SimApp) keeper.Keeper {
+	return keeper.NewKeeper(
+		app.AppCodec(),
+		runtime.NewKVStoreService(app.GetKey(types.StoreKey)),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.IBCFeeKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
+		app.IBCKeeper.PortKeeper,
+		app.AccountKeeper,
+		nftKeeperWithoutIterator{adapter.NewKeeper(app.NFTKeeper, adapter.NewRegistry(app.AppCodec()))},
+		app.ScopedNFTTransferKeeper,
+	)
+}
+
+// TestMigrate1to2WithoutIterator tests that the migration succeeds without
+// building the escrow index if the NFTKeeper cannot enumerate the tokens.
+func (suite *KeeperTestSuite) TestMigrate1to2WithoutIterator() {
+	suite.SetupTest() // reset
+
+	classID := "cryptoCat"
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	suite.mintNFTs(classID, "kitty1")
+
+	app := suite.GetSimApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(app.NFTKeeper.Transfer(ctx, classID, "kitty1", escrowAddress))
+
+	nftTransferKeeper := newKeeperWithoutIterator(app)
+	suite.Require().NoError(keeper.NewMigrator(nftTransferKeeper).Migrate1to2(ctx))
+	suite.Require().Empty(nftTransferKeeper.GetAllEscrowedTokens(ctx))
+}
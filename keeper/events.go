@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 
 	"github.com/bianjieai/nft-transfer/types"
@@ -34,3 +35,74 @@ func EmitAcknowledgementEvent(ctx sdk.Context, data types.NonFungibleTokenPacket
 		),
 	)
 }
+
+// emitTransferSentEvents emits the typed events of the tokens sent with the
+// packet of the given sequence.
+func (k Keeper) emitTransferSentEvents(ctx sdk.Context, sourcePort, sourceChannel string, sequence uint64,
+	classID string, tokenIDs []string, sender sdk.AccAddress, receiver, memo string) error {
+	classTrace, err := k.getClassTrace(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	direction := types.GetTransferDirection(types.IsAwayFromOrigin(sourcePort, sourceChannel, classTrace.GetFullClassPath()))
+	if direction == types.DirectionEscrow {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventEscrowed{
+			PortId:         sourcePort,
+			ChannelId:      sourceChannel,
+			Sequence:       sequence,
+			VoucherClassId: classID,
+			TokenIds:       tokenIDs,
+			EscrowAddress:  types.GetEscrowAddress(sourcePort, sourceChannel).String(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventTransferSent{
+		PortId:         sourcePort,
+		ChannelId:      sourceChannel,
+		Sequence:       sequence,
+		Sender:         sender.String(),
+		Receiver:       receiver,
+		ClassTrace:     classTrace,
+		VoucherClassId: classID,
+		TokenIds:       tokenIDs,
+		Direction:      direction,
+		Memo:           memo,
+	})
+}
+
+// newPacketReceivedEvent returns the typed event of the tokens received with
+// the given packet.
+func newPacketReceivedEvent(packet channeltypes.Packet, data types.NonFungibleTokenPacketData,
+	classTrace types.ClassTrace, voucherClassID string, direction types.TransferDirection) *types.EventPacketReceived {
+	return &types.EventPacketReceived{
+		PortId:         packet.GetDestPort(),
+		ChannelId:      packet.GetDestChannel(),
+		Sequence:       packet.GetSequence(),
+		Sender:         data.Sender,
+		Receiver:       data.Receiver,
+		ClassTrace:     classTrace,
+		VoucherClassId: voucherClassID,
+		TokenIds:       data.TokenIds,
+		Direction:      direction,
+	}
+}
+
+// newRefundedEvent returns the typed event of the tokens of the given packet
+// refunded to the refund address.
+func newRefundedEvent(packet channeltypes.Packet, info types.TransferInfo,
+	refundAddress sdk.AccAddress, direction types.TransferDirection) *types.EventRefunded {
+	return &types.EventRefunded{
+		PortId:         packet.GetSourcePort(),
+		ChannelId:      packet.GetSourceChannel(),
+		Sequence:       packet.GetSequence(),
+		Sender:         info.Sender,
+		RefundAddress:  refundAddress.String(),
+		ClassTrace:     info.ClassTrace,
+		VoucherClassId: info.VoucherClassID,
+		TokenIds:       info.TokenIDs,
+		Direction:      direction,
+	}
+}
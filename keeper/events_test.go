@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestTypedEvents tests that the typed events are emitted with the class trace,
+// the voucher class, the tokens and the direction of the transfers along the
+// send, receive, return and refund of tokens.
+func (suite *KeeperTestSuite) TestTypedEvents() {
+	suite.SetupTest() // reset
+
+	classID := "cryptoCat"
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	suite.mintNFTs(classID, "kitty1")
+
+	sender := suite.chainA.SenderAccount.GetAddress().String()
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+	portA, channelA := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	portB, channelB := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+	baseTrace := types.ClassTrace{BaseClassId: classID}
+	voucherTrace := types.ParseClassTrace(types.GetClassPrefix(portB, channelB) + classID)
+	voucherClassID := voucherTrace.IBCClassID()
+
+	// transfer sends the token from the chain of the endpoint and receives it on
+	// the counterparty chain, returning the packet and the events emitted
+	transfer := func(endpoint *ibctesting.Endpoint, classID, sender string) (channeltypes.Packet, []abci.Event, []abci.Event) {
+		msg := types.NewMsgTransfer(
+			endpoint.ChannelConfig.PortID,
+			endpoint.ChannelID,
+			classID,
+			[]string{"kitty1"},
+			sender,
+			endpoint.Counterparty.Chain.SenderAccount.GetAddress().String(),
+			endpoint.Counterparty.Chain.GetTimeoutHeight(),
+			0,
+			"",
+		)
+		sendRes, err := endpoint.Chain.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packet, err := ibctesting.ParsePacketFromEvents(sendRes.GetEvents())
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(endpoint.Counterparty.UpdateClient())
+		recvRes, err := endpoint.Counterparty.RecvPacketWithResult(packet)
+		suite.Require().NoError(err)
+		return packet, sendRes.GetEvents(), recvRes.GetEvents()
+	}
+
+	// tokens escrowed on send and vouchers minted on receive
+	packet, sendEvents, recvEvents := transfer(path.EndpointA, classID, sender)
+	suite.Require().Equal([]proto.Message{
+		&types.EventEscrowed{
+			PortId: portA, ChannelId: channelA, Sequence: packet.Sequence,
+			VoucherClassId: classID, TokenIds: []string{"kitty1"},
+			EscrowAddress: types.GetEscrowAddress(portA, channelA).String(),
+		},
+		&types.EventTransferSent{
+			PortId: portA, ChannelId: channelA, Sequence: packet.Sequence,
+			Sender: sender, Receiver: receiver, ClassTrace: baseTrace,
+			VoucherClassId: classID, TokenIds: []string{"kitty1"}, Direction: types.DirectionEscrow,
+		},
+	}, suite.typedEvents(sendEvents))
+	suite.Require().Equal([]proto.Message{
+		&types.EventClassTraceCreated{
+			ClassTrace: voucherTrace, TraceHash: voucherTrace.Hash().String(), VoucherClassId: voucherClassID,
+		},
+		&types.EventVoucherMinted{
+			PortId: portB, ChannelId: channelB, Sequence: packet.Sequence, ClassTrace: voucherTrace,
+			VoucherClassId: voucherClassID, TokenIds: []string{"kitty1"}, Owner: receiver,
+		},
+		&types.EventPacketReceived{
+			PortId: portB, ChannelId: channelB, Sequence: packet.Sequence,
+			Sender: sender, Receiver: receiver, ClassTrace: voucherTrace,
+			VoucherClassId: voucherClassID, TokenIds: []string{"kitty1"}, Direction: types.DirectionEscrow,
+		},
+	}, suite.typedEvents(recvEvents))
+
+	// vouchers burnt on send, refunded by minting them again
+	packet, sendEvents, recvEvents = transfer(path.EndpointB, voucherClassID, receiver)
+	suite.Require().Equal([]proto.Message{
+		&types.EventTransferSent{
+			PortId: portB, ChannelId: channelB, Sequence: packet.Sequence,
+			Sender: receiver, Receiver: sender, ClassTrace: voucherTrace,
+			VoucherClassId: voucherClassID, TokenIds: []string{"kitty1"}, Direction: types.DirectionBurn,
+		},
+	}, suite.typedEvents(sendEvents))
+	suite.Require().Equal([]proto.Message{
+		&types.EventUnescrowed{
+			PortId: portA, ChannelId: channelA, Sequence: packet.Sequence,
+			VoucherClassId: classID, TokenIds: []string{"kitty1"},
+			EscrowAddress: types.GetEscrowAddress(portA, channelA).String(), Receiver: sender,
+		},
+		&types.EventPacketReceived{
+			PortId: portA, ChannelId: channelA, Sequence: packet.Sequence,
+			Sender: receiver, Receiver: sender, ClassTrace: baseTrace,
+			VoucherClassId: classID, TokenIds: []string{"kitty1"}, Direction: types.DirectionBurn,
+		},
+	}, suite.typedEvents(recvEvents))
+
+	var data types.NonFungibleTokenPacketData
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+	ctx := suite.chainB.GetContext()
+	suite.Require().NoError(suite.GetSimApp(suite.chainB).NFTTransferKeeper.OnTimeoutPacket(ctx, packet, data))
+	suite.Require().Equal([]proto.Message{
+		&types.EventVoucherMinted{
+			PortId: portB, ChannelId: channelB, Sequence: packet.Sequence, ClassTrace: voucherTrace,
+			VoucherClassId: voucherClassID, TokenIds: []string{"kitty1"}, Owner: receiver,
+		},
+		&types.EventRefunded{
+			PortId: portB, ChannelId: channelB, Sequence: packet.Sequence,
+			Sender: receiver, RefundAddress: receiver, ClassTrace: voucherTrace,
+			VoucherClassId: voucherClassID, TokenIds: []string{"kitty1"}, Direction: types.DirectionBurn,
+		},
+	}, suite.typedEvents(ctx.EventManager().ABCIEvents()))
+}
+
+// typedEvents returns the typed nft-transfer events among the given events.
+func (suite *KeeperTestSuite) typedEvents(events []abci.Event) []proto.Message {
+	var msgs []proto.Message
+	for _, event := range events {
+		if !strings.HasPrefix(event.Type, "ibc.applications.nft_transfer.v1.Event") {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		suite.Require().NoError(err)
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
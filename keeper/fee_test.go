@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+var feeTransferVersion = string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{
+	FeeVersion: feetypes.Version,
+	AppVersion: types.Version,
+}))
+
+// NewFeeTransferPath returns a path whose channel is opened with the fee-wrapped
+// nft-transfer version.
+func NewFeeTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := NewTransferPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.Version = feeTransferVersion
+	path.EndpointB.ChannelConfig.Version = feeTransferVersion
+	return path
+}
+
+// TestFeeMiddleware tests that the relayers of an incentivized nft-transfer packet
+// are paid for relaying the packet, its acknowledgement or its timeout.
+func (suite *KeeperTestSuite) TestFeeMiddleware() {
+	var (
+		path    *ibctesting.Path
+		classID = "cryptoCat"
+		fee     = feetypes.NewFee(
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(200))),
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(300))),
+		)
+		// the addresses on chainA paid for relaying the packet to chainB and for
+		// relaying its acknowledgement or timeout back to chainA
+		recvPayee  = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		relayPayee = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	)
+
+	testCases := []struct {
+		msg              string
+		timeoutTimestamp func() uint64
+		relay            func(packet channeltypes.Packet)
+		expPaid          map[string]sdk.Coins
+		expOwner         func() sdk.AccAddress
+	}{
+		{
+			"recv and ack fees paid",
+			func() uint64 { return 0 },
+			func(packet channeltypes.Packet) {
+				suite.Require().NoError(path.RelayPacket(packet))
+			},
+			map[string]sdk.Coins{
+				recvPayee.String():  fee.RecvFee,
+				relayPayee.String(): fee.AckFee,
+			},
+			func() sdk.AccAddress {
+				return types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			},
+		},
+		{
+			"timeout fee paid",
+			func() uint64 {
+				return uint64(suite.chainB.GetContext().BlockTime().Add(time.Second).UnixNano())
+			},
+			func(packet channeltypes.Packet) {
+				suite.coordinator.IncrementTimeBy(time.Minute)
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+			},
+			map[string]sdk.Coins{
+				recvPayee.String():  nil,
+				relayPayee.String(): fee.TimeoutFee,
+			},
+			func() sdk.AccAddress {
+				return suite.chainA.SenderAccount.GetAddress()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewFeeTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs(classID, "kitty1")
+
+			feeKeeperA := suite.GetSimApp(suite.chainA).IBCFeeKeeper
+			feeKeeperB := suite.GetSimApp(suite.chainB).IBCFeeKeeper
+			suite.Require().True(feeKeeperA.IsFeeEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().Equal(feeTransferVersion, path.EndpointA.GetChannel().Version)
+
+			// the relayers of chainA and chainB are the sender accounts of the chains
+			relayerA := suite.chainA.SenderAccount.GetAddress().String()
+			relayerB := suite.chainB.SenderAccount.GetAddress().String()
+			feeKeeperA.SetPayeeAddress(suite.chainA.GetContext(), relayerA, relayPayee.String(), path.EndpointA.ChannelID)
+			feeKeeperB.SetCounterpartyPayeeAddress(suite.chainB.GetContext(), relayerB, recvPayee.String(), path.EndpointB.ChannelID)
+
+			timeoutTimestamp := tc.timeoutTimestamp()
+			timeoutHeight := suite.chainB.GetTimeoutHeight()
+			if timeoutTimestamp != 0 {
+				timeoutHeight = clienttypes.ZeroHeight()
+			}
+			msgPayPacketFee := feetypes.NewMsgPayPacketFee(
+				fee, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, relayerA, nil,
+			)
+			msgTransfer := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				classID,
+				[]string{"kitty1"},
+				relayerA,
+				relayerB,
+				timeoutHeight,
+				timeoutTimestamp,
+				"",
+			)
+			res, err := suite.chainA.SendMsgs(msgPayPacketFee, msgTransfer)
+			suite.Require().NoError(err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			packetID := channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			_, found := feeKeeperA.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+			suite.Require().True(found)
+
+			tc.relay(packet)
+
+			ctx := suite.chainA.GetContext()
+			for payee, expPaid := range tc.expPaid {
+				addr, err := sdk.AccAddressFromBech32(payee)
+				suite.Require().NoError(err)
+				balance := suite.GetSimApp(suite.chainA).BankKeeper.GetAllBalances(ctx, addr)
+				suite.Require().True(expPaid.Equal(balance), "payee %s: expected %s, got %s", payee, expPaid, balance)
+			}
+			_, found = feeKeeperA.GetFeesInEscrow(ctx, packetID)
+			suite.Require().False(found)
+			suite.Require().Equal(tc.expOwner(), suite.GetSimApp(suite.chainA).NFTKeeper.GetOwner(ctx, classID, "kitty1"))
+		})
+	}
+}
+
+// TestUpgradeToFeeChannel tests that an existing nft-transfer channel can be
+// upgraded to an incentivized channel.
+func (suite *KeeperTestSuite) TestUpgradeToFeeChannel() {
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = feeTransferVersion
+	path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = feeTransferVersion
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+	suite.Require().Equal(feeTransferVersion, path.EndpointA.GetChannel().Version)
+	suite.Require().Equal(feeTransferVersion, path.EndpointB.GetChannel().Version)
+	suite.Require().True(suite.GetSimApp(suite.chainA).IBCFeeKeeper.IsFeeEnabled(
+		suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+	))
+	suite.Require().True(suite.GetSimApp(suite.chainB).IBCFeeKeeper.IsFeeEnabled(
+		suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+	))
+}
@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// ForwardTransferPacket sends the tokens received with the given packet onward
+// as described by the forwarding instruction. The received tokens must already be
+// held by the forward address set as receiver in data. The acknowledgement of the
+// received packet is delayed until the forwarded packet is acknowledged or timed out.
+func (k Keeper) ForwardTransferPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data types.NonFungibleTokenPacketData,
+	metadata *types.ForwardMetadata,
+) error {
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	classID, err := k.GetReceivedClassID(ctx, packet, data)
+	if err != nil {
+		return err
+	}
+
+	timeout, err := metadata.GetTimeout()
+	if err != nil {
+		return err
+	}
+
+	memo, err := metadata.GetNextMemo()
+	if err != nil {
+		return err
+	}
+
+	sequences, err := k.SendTransfer(
+		ctx,
+		metadata.Port,
+		metadata.Channel,
+		classID,
+		data.TokenIds,
+		receiver,
+		metadata.Receiver,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(timeout).UnixNano()),
+		memo,
+	)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrForwardFailed, err.Error())
+	}
+	// the acknowledgement of the received packet waits for a single forwarded packet
+	if len(sequences) != 1 {
+		return errorsmod.Wrapf(types.ErrForwardFailed, "the tokens are split into %d packets", len(sequences))
+	}
+	sequence := sequences[0]
+
+	k.SetInFlightPacket(ctx, types.NewInFlightPacket(metadata.Port, metadata.Channel, sequence, packet))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyClassID, classID),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, metadata.Port),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, metadata.Channel),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefundChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		),
+	)
+	return nil
+}
+
+// OnForwardAcknowledgementPacket writes the acknowledgement of the original packet
+// once the packet forwarded on its behalf is acknowledged. If the forwarded packet
+// failed, the tokens refunded to the forward address are returned to where they were
+// received from and the error is passed back to the previous hop, which then refunds
+// its own sender. It does nothing if the packet was not forwarded by this chain.
+func (k Keeper) OnForwardAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	return k.resolveForwardedPacket(ctx, packet, ack)
+}
+
+// OnForwardTimeoutPacket writes an error acknowledgement for the original packet
+// once the packet forwarded on its behalf timed out. It does nothing if the packet
+// was not forwarded by this chain.
+func (k Keeper) OnForwardTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	ack := channeltypes.NewErrorAcknowledgement(
+		errorsmod.Wrapf(types.ErrForwardFailed, "forwarded packet %d timed out", packet.GetSequence()),
+	)
+	return k.resolveForwardedPacket(ctx, packet, ack)
+}
+
+func (k Keeper) resolveForwardedPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeleteInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	originalPacket := inFlightPacket.GetPacket()
+	var data types.NonFungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(originalPacket.GetData(), &data); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-721 transfer packet data: %s", err.Error())
+	}
+	data.Receiver = types.GetForwardAddress(originalPacket.GetDestChannel(), data.Sender).String()
+
+	if ack.Success() {
+		// the result of the next hop describes the tokens on the next chain, while
+		// the previous hop expects the tokens received by this chain
+		result, err := k.getForwardedPacketResult(ctx, originalPacket, data)
+		if err != nil {
+			return err
+		}
+		ack = result.Acknowledgement()
+	} else if err := k.revertReceivedPacket(ctx, originalPacket, data); err != nil {
+		return err
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(originalPacket.GetDestPort(), originalPacket.GetDestChannel()))
+	if !ok {
+		return errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, channelCap, originalPacket, ack)
+}
+
+// getForwardedPacketResult returns the result of the given packet received by
+// the forward address set as receiver in data, whose tokens were then forwarded.
+func (k Keeper) getForwardedPacketResult(ctx sdk.Context, packet channeltypes.Packet,
+	data types.NonFungibleTokenPacketData) (types.NonFungibleTokenPacketResult, error) {
+	voucherClassID, err := k.GetReceivedClassID(ctx, packet, data)
+	if err != nil {
+		return types.NonFungibleTokenPacketResult{}, err
+	}
+	classTrace, err := k.getClassTrace(ctx, voucherClassID)
+	if err != nil {
+		return types.NonFungibleTokenPacketResult{}, err
+	}
+
+	// the tokens returned to their chain of origin are not vouchers
+	var classTraceHash string
+	if classTrace.Path != "" {
+		classTraceHash = classTrace.Hash().String()
+	}
+	return types.NewNonFungibleTokenPacketResult(voucherClassID, classTraceHash, data.Receiver), nil
+}
+
+// revertReceivedPacket undoes processReceivedPacket for tokens held by the receiver
+// of the packet: vouchers minted on receive are burnt and unescrowed tokens are moved
+// back into the escrow account of the receiving channel.
+func (k Keeper) revertReceivedPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	voucherClassID, err := k.GetReceivedClassID(ctx, packet, data)
+	if err != nil {
+		return err
+	}
+
+	isAwayFromOrigin := types.IsAwayFromOrigin(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId)
+	for _, tokenID := range data.TokenIds {
+		owner := k.nftKeeper.GetOwner(ctx, voucherClassID, tokenID)
+		if !receiver.Equals(owner) {
+			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not token owner")
+		}
+
+		if isAwayFromOrigin {
+			if err := k.nftKeeper.Burn(ctx, voucherClassID, tokenID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := k.escrowToken(ctx, packet.GetDestPort(), packet.GetDestChannel(), voucherClassID, tokenID, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetInFlightPacket returns the in-flight packet forwarded with the given port, channel and sequence.
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	packet, err := k.inFlightPackets.Get(ctx, collections.Join3(portID, channelID, sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.InFlightPacket{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return packet, true
+}
+
+// SetInFlightPacket stores the in-flight packet under the forwarded port, channel and sequence.
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, packet types.InFlightPacket) {
+	if err := k.inFlightPackets.Set(ctx, collections.Join3(packet.ForwardPortId, packet.ForwardChannelId, packet.ForwardSequence), packet); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteInFlightPacket removes the in-flight packet forwarded with the given port, channel and sequence.
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	if err := k.inFlightPackets.Remove(ctx, collections.Join3(portID, channelID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllInFlightPackets returns all the in-flight packets.
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	packets := []types.InFlightPacket{}
+	k.IterateInFlightPackets(ctx, func(packet types.InFlightPacket) bool {
+		packets = append(packets, packet)
+		return false
+	})
+	return packets
+}
+
+// IterateInFlightPackets iterates over the in-flight packets in the store
+// and performs a callback function.
+func (k Keeper) IterateInFlightPackets(ctx sdk.Context, cb func(packet types.InFlightPacket) bool) {
+	err := k.inFlightPackets.Walk(ctx, nil, func(_ collections.Triple[string, string, uint64], packet types.InFlightPacket) (bool, error) {
+		return cb(packet), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// MustUnmarshalInFlightPacket attempts to decode and return an InFlightPacket object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalInFlightPacket(bz []byte) types.InFlightPacket {
+	var packet types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet
+}
@@ -0,0 +1,457 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/x/nft"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// The following tests send a token from chainA to chainC through chainB with a
+// forwarding instruction in the memo: A -> B -> C
+func (suite *KeeperTestSuite) TestForwardTransfer() {
+	var (
+		classID  = "cryptoCat"
+		nftID    = "kitty"
+		receiver string
+		timeout  string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		relay    func(pathB2C *ibctesting.Path, forwardPacket channeltypes.Packet) []byte
+		expPass  bool
+	}{
+		{
+			"success", func() {},
+			func(pathB2C *ibctesting.Path, forwardPacket channeltypes.Packet) []byte {
+				_, ack, err := pathB2C.RelayPacketWithResults(forwardPacket)
+				suite.Require().NoError(err)
+				return ack
+			},
+			true,
+		},
+		{
+			"forwarded packet fails on chainC", func() {
+				receiver = "invalid receiver"
+			},
+			func(pathB2C *ibctesting.Path, forwardPacket channeltypes.Packet) []byte {
+				_, ack, err := pathB2C.RelayPacketWithResults(forwardPacket)
+				suite.Require().NoError(err)
+				return ack
+			},
+			false,
+		},
+		{
+			"forwarded packet times out", func() {
+				timeout = "1s"
+			},
+			func(pathB2C *ibctesting.Path, forwardPacket channeltypes.Packet) []byte {
+				suite.coordinator.IncrementTimeBy(time.Minute)
+				suite.coordinator.CommitBlock(suite.chainC)
+				suite.Require().NoError(pathB2C.EndpointA.UpdateClient())
+				suite.Require().NoError(pathB2C.EndpointA.TimeoutPacket(forwardPacket))
+				return channeltypes.NewErrorAcknowledgement(types.ErrForwardFailed).Acknowledgement()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			pathA2B := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(pathA2B)
+			pathB2C := NewTransferPath(suite.chainB, suite.chainC)
+			suite.coordinator.Setup(pathB2C)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver = suite.chainC.SenderAccount.GetAddress().String()
+			timeout = ""
+
+			nftKeeper := suite.GetSimApp(suite.chainA).NFTKeeper
+			err := nftKeeper.SaveClass(suite.chainA.GetContext(), nft.Class{
+				Id:   classID,
+				Data: suite.classMetadata,
+			})
+			suite.Require().NoError(err, "SaveClass error")
+			err = nftKeeper.Mint(suite.chainA.GetContext(), nft.NFT{
+				ClassId: classID,
+				Id:      nftID,
+				Data:    suite.tokenMetadata,
+			}, sender)
+			suite.Require().NoError(err, "Mint error")
+
+			tc.malleate()
+
+			memo := fmt.Sprintf(`{"forward":{"receiver":%q,"port":%q,"channel":%q,"timeout":%q}}`,
+				receiver, pathB2C.EndpointA.ChannelConfig.PortID, pathB2C.EndpointA.ChannelID, timeout)
+			msg := types.NewMsgTransfer(
+				pathA2B.EndpointA.ChannelConfig.PortID,
+				pathA2B.EndpointA.ChannelID,
+				classID,
+				[]string{nftID},
+				sender.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(),
+				0,
+				memo,
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			// receive on chainB, which forwards the tokens and holds back the acknowledgement
+			suite.Require().NoError(pathA2B.EndpointB.UpdateClient())
+			res, err = pathA2B.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+			_, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().Error(err, "acknowledgement must be written asynchronously")
+
+			forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.Require().Equal(pathB2C.EndpointA.ChannelID, forwardPacket.GetSourceChannel())
+
+			appB := suite.GetSimApp(suite.chainB)
+			_, found := appB.NFTTransferKeeper.GetInFlightPacket(suite.chainB.GetContext(),
+				forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence())
+			suite.Require().True(found)
+
+			voucherClassIDOnB := types.ParseClassTrace(
+				types.GetClassPrefix(pathA2B.EndpointB.ChannelConfig.PortID, pathA2B.EndpointB.ChannelID) + classID,
+			).IBCClassID()
+			voucherClassIDOnC := types.ParseClassTrace(
+				types.GetClassPrefix(pathB2C.EndpointB.ChannelConfig.PortID, pathB2C.EndpointB.ChannelID) +
+					types.GetClassPrefix(pathA2B.EndpointB.ChannelConfig.PortID, pathA2B.EndpointB.ChannelID) + classID,
+			).IBCClassID()
+
+			// the acknowledgement of the forwarded packet is passed back to chainA
+			ack := tc.relay(pathB2C, forwardPacket)
+			if tc.expPass {
+				// chainB acknowledges the tokens it received rather than those received by chainC
+				classTraceOnB := types.ClassTrace{BaseClassId: classID}.
+					AddPrefix(pathA2B.EndpointB.ChannelConfig.PortID, pathA2B.EndpointB.ChannelID)
+				ack = forwardAck(pathA2B, classTraceOnB, sender.String())
+			}
+
+			_, found = appB.NFTTransferKeeper.GetInFlightPacket(suite.chainB.GetContext(),
+				forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence())
+			suite.Require().False(found)
+			ackCommitment, found := appB.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(),
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().True(found)
+			suite.Require().Equal(channeltypes.CommitAcknowledgement(ack), ackCommitment)
+
+			suite.Require().NoError(pathA2B.EndpointA.UpdateClient())
+			suite.Require().NoError(pathA2B.EndpointA.AcknowledgePacket(packet, ack))
+
+			if tc.expPass {
+				suite.Require().Equal(
+					suite.chainC.SenderAccount.GetAddress(),
+					suite.GetSimApp(suite.chainC).NFTKeeper.GetOwner(suite.chainC.GetContext(), voucherClassIDOnC, nftID),
+				)
+				suite.Require().Equal(
+					types.GetEscrowAddress(pathB2C.EndpointA.ChannelConfig.PortID, pathB2C.EndpointA.ChannelID),
+					appB.NFTKeeper.GetOwner(suite.chainB.GetContext(), voucherClassIDOnB, nftID),
+				)
+				suite.Require().Equal(
+					types.GetEscrowAddress(pathA2B.EndpointA.ChannelConfig.PortID, pathA2B.EndpointA.ChannelID),
+					nftKeeper.GetOwner(suite.chainA.GetContext(), classID, nftID),
+				)
+				return
+			}
+
+			// the voucher on chainB is burnt and the token is refunded on chainA
+			suite.Require().False(appB.NFTKeeper.HasNFT(suite.chainB.GetContext(), voucherClassIDOnB, nftID))
+			suite.Require().False(suite.GetSimApp(suite.chainC).NFTKeeper.HasNFT(suite.chainC.GetContext(), voucherClassIDOnC, nftID))
+			suite.Require().Equal(sender, nftKeeper.GetOwner(suite.chainA.GetContext(), classID, nftID))
+		})
+	}
+}
+
+// TestForwardTransferMultiHop tests a transfer forwarded twice with a nested
+// forwarding instruction, going around the three chains: A -> B -> C -> A
+func (suite *KeeperTestSuite) TestForwardTransferMultiHop() {
+	var (
+		classID  = "cryptoCat"
+		nftID    = "kitty"
+		receiver string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"last hop fails: refunded through every hop", func() {
+			receiver = "invalid receiver"
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			pathA2B := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(pathA2B)
+			pathB2C := NewTransferPath(suite.chainB, suite.chainC)
+			suite.coordinator.Setup(pathB2C)
+			pathC2A := NewTransferPath(suite.chainC, suite.chainA)
+			suite.coordinator.Setup(pathC2A)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver = sender.String()
+			suite.mintNFTs(classID, nftID)
+
+			tc.malleate()
+
+			memo := fmt.Sprintf(`{"forward":{"receiver":"unused","port":%q,"channel":%q,"next":{"forward":{"receiver":%q,"port":%q,"channel":%q}}}}`,
+				pathB2C.EndpointA.ChannelConfig.PortID, pathB2C.EndpointA.ChannelID,
+				receiver, pathC2A.EndpointA.ChannelConfig.PortID, pathC2A.EndpointA.ChannelID)
+			msg := types.NewMsgTransfer(
+				pathA2B.EndpointA.ChannelConfig.PortID,
+				pathA2B.EndpointA.ChannelID,
+				classID,
+				[]string{nftID},
+				sender.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(),
+				0,
+				memo,
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			acks := suite.relayForwardedPacket(packet, pathA2B, pathB2C, pathC2A)
+
+			classTraceOnB := types.ClassTrace{BaseClassId: classID}.
+				AddPrefix(pathA2B.EndpointB.ChannelConfig.PortID, pathA2B.EndpointB.ChannelID)
+			classTraceOnC := classTraceOnB.AddPrefix(pathB2C.EndpointB.ChannelConfig.PortID, pathB2C.EndpointB.ChannelID)
+			classTraceOnA := classTraceOnC.AddPrefix(pathC2A.EndpointB.ChannelConfig.PortID, pathC2A.EndpointB.ChannelID)
+			appA, appB, appC := suite.GetSimApp(suite.chainA), suite.GetSimApp(suite.chainB), suite.GetSimApp(suite.chainC)
+			suite.Require().Empty(appB.NFTTransferKeeper.GetAllInFlightPackets(suite.chainB.GetContext()))
+			suite.Require().Empty(appC.NFTTransferKeeper.GetAllInFlightPackets(suite.chainC.GetContext()))
+
+			if tc.expPass {
+				// each chain acknowledges the tokens it received
+				suite.Require().Equal(forwardAck(pathA2B, classTraceOnB, sender.String()), acks[0])
+				suite.Require().Equal(forwardAck(pathB2C, classTraceOnC, types.GetForwardAddress(pathA2B.EndpointB.ChannelID, sender.String()).String()), acks[1])
+				suite.Require().Equal(
+					types.NewNonFungibleTokenPacketResult(classTraceOnA.IBCClassID(), classTraceOnA.Hash().String(), sender.String()).
+						Acknowledgement().Acknowledgement(),
+					acks[2],
+				)
+
+				suite.Require().Equal(sender, appA.NFTKeeper.GetOwner(suite.chainA.GetContext(), classTraceOnA.IBCClassID(), nftID))
+				suite.Require().Equal(
+					types.GetEscrowAddress(pathC2A.EndpointA.ChannelConfig.PortID, pathC2A.EndpointA.ChannelID),
+					appC.NFTKeeper.GetOwner(suite.chainC.GetContext(), classTraceOnC.IBCClassID(), nftID),
+				)
+				suite.Require().Equal(
+					types.GetEscrowAddress(pathB2C.EndpointA.ChannelConfig.PortID, pathB2C.EndpointA.ChannelID),
+					appB.NFTKeeper.GetOwner(suite.chainB.GetContext(), classTraceOnB.IBCClassID(), nftID),
+				)
+				suite.Require().Equal(
+					types.GetEscrowAddress(pathA2B.EndpointA.ChannelConfig.PortID, pathA2B.EndpointA.ChannelID),
+					appA.NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, nftID),
+				)
+				return
+			}
+
+			// the error of the last hop is passed back to chainA through every hop
+			suite.Require().Contains(string(acks[2]), "error")
+			suite.Require().Equal(acks[2], acks[1])
+			suite.Require().Equal(acks[2], acks[0])
+			suite.Require().False(appA.NFTKeeper.HasNFT(suite.chainA.GetContext(), classTraceOnA.IBCClassID(), nftID))
+			suite.Require().False(appC.NFTKeeper.HasNFT(suite.chainC.GetContext(), classTraceOnC.IBCClassID(), nftID))
+			suite.Require().False(appB.NFTKeeper.HasNFT(suite.chainB.GetContext(), classTraceOnB.IBCClassID(), nftID))
+			suite.Require().Equal(sender, appA.NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, nftID))
+		})
+	}
+}
+
+// TestForwardTransferToOrigin tests a voucher forwarded by its chain of origin,
+// which unescrows the token before sending it onward: C -> A -> B
+func (suite *KeeperTestSuite) TestForwardTransferToOrigin() {
+	var (
+		classID  = "cryptoCat"
+		nftID    = "kitty"
+		receiver string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"forwarded packet fails on chainB: escrowed again on chainA", func() {
+			receiver = "invalid receiver"
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			pathA2B := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(pathA2B)
+			pathC2A := NewTransferPath(suite.chainC, suite.chainA)
+			suite.coordinator.Setup(pathC2A)
+
+			receiver = suite.chainB.SenderAccount.GetAddress().String()
+			suite.mintNFTs(classID, nftID)
+
+			// chainA first sends the token to chainC
+			msg := types.NewMsgTransfer(
+				pathC2A.EndpointB.ChannelConfig.PortID,
+				pathC2A.EndpointB.ChannelID,
+				classID,
+				[]string{nftID},
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainC.SenderAccount.GetAddress().String(),
+				suite.chainC.GetTimeoutHeight(),
+				0,
+				"",
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.Require().NoError(pathC2A.RelayPacket(packet))
+
+			tc.malleate()
+
+			sender := suite.chainC.SenderAccount.GetAddress()
+			classTraceOnC := types.ClassTrace{BaseClassId: classID}.
+				AddPrefix(pathC2A.EndpointA.ChannelConfig.PortID, pathC2A.EndpointA.ChannelID)
+			memo := fmt.Sprintf(`{"forward":{"receiver":%q,"port":%q,"channel":%q}}`,
+				receiver, pathA2B.EndpointA.ChannelConfig.PortID, pathA2B.EndpointA.ChannelID)
+			msg = types.NewMsgTransfer(
+				pathC2A.EndpointA.ChannelConfig.PortID,
+				pathC2A.EndpointA.ChannelID,
+				classTraceOnC.IBCClassID(),
+				[]string{nftID},
+				sender.String(),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainA.GetTimeoutHeight(),
+				0,
+				memo,
+			)
+			res, err = suite.chainC.SendMsgs(msg)
+			suite.Require().NoError(err)
+			packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			acks := suite.relayForwardedPacket(packet, pathC2A, pathA2B)
+
+			appA, appB, appC := suite.GetSimApp(suite.chainA), suite.GetSimApp(suite.chainB), suite.GetSimApp(suite.chainC)
+			classTraceOnB := types.ClassTrace{BaseClassId: classID}.
+				AddPrefix(pathA2B.EndpointB.ChannelConfig.PortID, pathA2B.EndpointB.ChannelID)
+			suite.Require().Empty(appA.NFTTransferKeeper.GetAllInFlightPackets(suite.chainA.GetContext()))
+
+			if tc.expPass {
+				// chainA acknowledges the unescrowed token, which is not a voucher
+				suite.Require().Equal(forwardAck(pathC2A, types.ClassTrace{BaseClassId: classID}, sender.String()), acks[0])
+				suite.Require().False(appC.NFTKeeper.HasNFT(suite.chainC.GetContext(), classTraceOnC.IBCClassID(), nftID))
+				suite.Require().Equal(
+					types.GetEscrowAddress(pathA2B.EndpointA.ChannelConfig.PortID, pathA2B.EndpointA.ChannelID),
+					appA.NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, nftID),
+				)
+				suite.Require().Equal(
+					suite.chainB.SenderAccount.GetAddress(),
+					appB.NFTKeeper.GetOwner(suite.chainB.GetContext(), classTraceOnB.IBCClassID(), nftID),
+				)
+				return
+			}
+
+			// the token is escrowed again for chainC, which refunds the voucher
+			suite.Require().Contains(string(acks[1]), "error")
+			suite.Require().Equal(acks[1], acks[0])
+			suite.Require().Equal(
+				types.GetEscrowAddress(pathC2A.EndpointB.ChannelConfig.PortID, pathC2A.EndpointB.ChannelID),
+				appA.NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, nftID),
+			)
+			suite.Require().Equal(sender, appC.NFTKeeper.GetOwner(suite.chainC.GetContext(), classTraceOnC.IBCClassID(), nftID))
+			suite.Require().False(appB.NFTKeeper.HasNFT(suite.chainB.GetContext(), classTraceOnB.IBCClassID(), nftID))
+		})
+	}
+}
+
+// relayForwardedPacket relays the given packet over the paths, each receiving
+// chain but the last forwarding the tokens over the next path, and then relays
+// the acknowledgements back to the sending chain. It returns the acknowledgements
+// written by the receiving chain of each path.
+func (suite *KeeperTestSuite) relayForwardedPacket(packet channeltypes.Packet, paths ...*ibctesting.Path) [][]byte {
+	packets := make([]channeltypes.Packet, len(paths))
+	acks := make([][]byte, len(paths))
+	for i, path := range paths {
+		packets[i] = packet
+		suite.Require().NoError(path.EndpointB.UpdateClient())
+		res, err := path.EndpointB.RecvPacketWithResult(packet)
+		suite.Require().NoError(err)
+
+		if i == len(paths)-1 {
+			acks[i], err = ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			break
+		}
+
+		// the forwarding chain holds back the acknowledgement
+		_, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+		suite.Require().Error(err, "acknowledgement must be written asynchronously")
+		packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+		suite.Require().NoError(err)
+	}
+
+	for i := len(paths) - 1; i > 0; i-- {
+		path, packet := paths[i], packets[i]
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		proof, proofHeight := path.EndpointB.QueryProof(
+			host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()),
+		)
+		res, err := path.EndpointA.Chain.SendMsgs(channeltypes.NewMsgAcknowledgement(
+			packet, acks[i], proof, proofHeight, path.EndpointA.Chain.SenderAccount.GetAddress().String(),
+		))
+		suite.Require().NoError(err)
+
+		// the acknowledgement of the previous hop is written once the forwarded packet is acknowledged
+		acks[i-1], err = ibctesting.ParseAckFromEvents(res.GetEvents())
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().NoError(paths[0].EndpointA.UpdateClient())
+	suite.Require().NoError(paths[0].EndpointA.AcknowledgePacket(packets[0], acks[0]))
+	return acks
+}
+
+// forwardAck returns the success acknowledgement written by the receiving chain of
+// the path for the tokens of the given class trace received by the forward address
+// of sender.
+func forwardAck(path *ibctesting.Path, classTrace types.ClassTrace, sender string) []byte {
+	var classTraceHash string
+	if classTrace.Path != "" {
+		classTraceHash = classTrace.Hash().String()
+	}
+	result := types.NewNonFungibleTokenPacketResult(
+		classTrace.IBCClassID(), classTraceHash, types.GetForwardAddress(path.EndpointB.ChannelID, sender).String(),
+	)
+	return result.Acknowledgement().Acknowledgement()
+}
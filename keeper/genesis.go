@@ -16,6 +16,47 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 		k.SetClassTrace(ctx, trace)
 	}
 
+	for _, packet := range state.InFlightPackets {
+		k.SetInFlightPacket(ctx, packet)
+	}
+
+	for _, rateLimit := range state.RateLimits {
+		k.SaveRateLimit(ctx, rateLimit)
+	}
+
+	for _, flow := range state.RateLimitFlows {
+		k.SetRateLimitFlow(ctx, flow)
+	}
+
+	for _, packet := range state.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, packet)
+	}
+
+	for _, token := range state.EscrowedTokens {
+		k.SetEscrowedToken(ctx, token)
+	}
+
+	for _, record := range state.RefundRecords {
+		k.SetRefundRecord(ctx, record)
+	}
+
+	for _, sendHeight := range state.PacketSendHeights {
+		k.SetPacketSendHeight(ctx, sendHeight)
+	}
+
+	nextEscrowReleaseID := uint64(1)
+	for _, record := range state.EscrowReleaseRecords {
+		k.SetEscrowReleaseRecord(ctx, record)
+		if record.Id >= nextEscrowReleaseID {
+			nextEscrowReleaseID = record.Id + 1
+		}
+	}
+	k.setNextEscrowReleaseID(ctx, nextEscrowReleaseID)
+
+	for _, pause := range state.Pauses {
+		k.SetPause(ctx, pause)
+	}
+
 	// Only try to bind to port if it is not already bound, since we may already own
 	// port capability from capability InitGenesis
 	if !k.IsBound(ctx, state.PortId) {
@@ -34,8 +75,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 // ExportGenesis exports ibc nft-transfer  module's portID and class trace info into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId: k.GetPort(ctx),
-		Traces: k.GetAllClassTraces(ctx),
-		Params: k.GetParams(ctx),
+		PortId:               k.GetPort(ctx),
+		Traces:               k.GetAllClassTraces(ctx),
+		Params:               k.GetParams(ctx),
+		InFlightPackets:      k.GetAllInFlightPackets(ctx),
+		RateLimits:           k.GetAllRateLimits(ctx),
+		RateLimitFlows:       k.GetAllRateLimitFlows(ctx),
+		PendingSendPackets:   k.GetAllPendingSendPackets(ctx),
+		EscrowedTokens:       k.GetAllEscrowedTokens(ctx),
+		RefundRecords:        k.GetAllRefundRecords(ctx),
+		PacketSendHeights:    k.GetAllPacketSendHeights(ctx),
+		EscrowReleaseRecords: k.GetAllEscrowReleaseRecords(ctx),
+		Pauses:               k.GetAllPauses(ctx),
 	}
 }
@@ -8,12 +8,14 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
-	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
 	"github.com/bianjieai/nft-transfer/types"
 )
 
@@ -53,23 +55,17 @@ func (k Keeper) ClassTraces(c context.Context,
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	traces := types.Traces{}
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
-	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
-		result, err := k.UnmarshalClassTrace(value)
-		if err != nil {
-			return err
-		}
-
-		traces = append(traces, result)
-		return nil
-	})
+	traces, pageRes, err := query.CollectionPaginate(ctx, k.classTraces, req.Pagination,
+		func(_ []byte, classTrace types.ClassTrace) (types.ClassTrace, error) {
+			return classTrace, nil
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.QueryClassTracesResponse{
-		ClassTraces: traces.Sort(),
+		ClassTraces: types.Traces(traces).Sort(),
 		Pagination:  pageRes,
 	}, nil
 }
@@ -128,3 +124,272 @@ func (k Keeper) Params(c context.Context,
 		Params: k.GetParams(ctx),
 	}, nil
 }
+
+// ChannelParams implements the ChannelParams gRPC method
+func (k Keeper) ChannelParams(c context.Context,
+	req *types.QueryChannelParamsRequest) (*types.QueryChannelParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryChannelParamsResponse{
+		ChannelParams: k.GetChannelParams(ctx, req.PortId, req.ChannelId),
+	}, nil
+}
+
+// RateLimits implements the Query/RateLimits gRPC method
+func (k Keeper) RateLimits(c context.Context,
+	req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rateLimits, pageRes, err := query.CollectionPaginate(ctx, k.rateLimits, req.Pagination,
+		func(_ collections.Triple[string, string, string], rateLimit types.RateLimit) (types.RateLimit, error) {
+			return rateLimit, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (k Keeper) RateLimit(c context.Context,
+	req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rateLimit, found := k.GetRateLimit(ctx, req.PortId, req.ChannelId, req.ClassId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrRateLimitNotFound, "port ID (%s) channel ID (%s) class ID (%s)", req.PortId, req.ChannelId, req.ClassId).Error(),
+		)
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit: rateLimit,
+		Flow:      k.getCurrentRateLimitFlow(ctx, rateLimit),
+	}, nil
+}
+
+// EscrowedTokens implements the Query/EscrowedTokens gRPC method
+func (k Keeper) EscrowedTokens(c context.Context,
+	req *types.QueryEscrowedTokensRequest) (*types.QueryEscrowedTokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	tokens, pageRes, err := query.CollectionPaginate(ctx, k.escrowedTokens, req.Pagination,
+		func(_ escrowedTokenKey, token types.EscrowedToken) (types.EscrowedToken, error) {
+			return token, nil
+		},
+		withPrefix(escrowedTokensPrefix(req.PortId, req.ChannelId, req.ClassId)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowedTokensResponse{
+		EscrowedTokens: tokens,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// EscrowedClassCounts implements the Query/EscrowedClassCounts gRPC method
+func (k Keeper) EscrowedClassCounts(c context.Context,
+	req *types.QueryEscrowedClassCountsRequest) (*types.QueryEscrowedClassCountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	classCounts, pageRes, err := query.CollectionPaginate(ctx, k.escrowedClassCounts, req.Pagination,
+		func(_ collections.Triple[string, string, string], classCount types.EscrowedClassCount) (types.EscrowedClassCount, error) {
+			return classCount, nil
+		},
+		withPrefix(collections.TripleSuperPrefix[string, string, string](req.PortId, req.ChannelId)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowedClassCountsResponse{
+		ClassCounts: classCounts,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// TokenEscrow implements the Query/TokenEscrow gRPC method
+func (k Keeper) TokenEscrow(c context.Context,
+	req *types.QueryTokenEscrowRequest) (*types.QueryTokenEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.ClassId) == "" || strings.TrimSpace(req.TokenId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "class id and token id cannot be blank")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	token, found := k.GetTokenEscrow(ctx, req.ClassId, req.TokenId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrEscrowedTokenNotFound, "class ID (%s) token ID (%s)", req.ClassId, req.TokenId).Error(),
+		)
+	}
+
+	return &types.QueryTokenEscrowResponse{
+		EscrowedToken: token,
+	}, nil
+}
+
+// RefundRecords implements the Query/RefundRecords gRPC method
+func (k Keeper) RefundRecords(c context.Context,
+	req *types.QueryRefundRecordsRequest) (*types.QueryRefundRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records, pageRes, err := query.CollectionPaginate(ctx, k.refundRecords, req.Pagination,
+		func(_ collections.Triple[string, string, uint64], record types.RefundRecord) (types.RefundRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRefundRecordsResponse{
+		RefundRecords: records,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// RefundRecord implements the Query/RefundRecord gRPC method
+func (k Keeper) RefundRecord(c context.Context,
+	req *types.QueryRefundRecordRequest) (*types.QueryRefundRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, found := k.GetRefundRecord(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrRefundRecordNotFound, "port ID (%s) channel ID (%s) sequence (%d)", req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryRefundRecordResponse{
+		RefundRecord: record,
+	}, nil
+}
+
+// EscrowReleaseRecords implements the Query/EscrowReleaseRecords gRPC method
+func (k Keeper) EscrowReleaseRecords(c context.Context,
+	req *types.QueryEscrowReleaseRecordsRequest) (*types.QueryEscrowReleaseRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records, pageRes, err := query.CollectionPaginate(ctx, k.escrowReleaseRecords, req.Pagination,
+		func(_ uint64, record types.EscrowReleaseRecord) (types.EscrowReleaseRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowReleaseRecordsResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Pauses implements the Query/Pauses gRPC method
+func (k Keeper) Pauses(c context.Context,
+	req *types.QueryPausesRequest) (*types.QueryPausesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pauses, pageRes, err := query.CollectionPaginate(ctx, k.pauses, req.Pagination,
+		func(_ collections.Triple[string, string, string], pause types.Pause) (types.Pause, error) {
+			return pause, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPausesResponse{
+		Pauses:     pauses,
+		Pagination: pageRes,
+	}, nil
+}
+
+// withPrefix restricts the pagination of a collection to the keys starting
+// with the given prefix.
+func withPrefix[K any](prefix K) func(o *query.CollectionsPaginateOptions[K]) {
+	return func(o *query.CollectionsPaginateOptions[K]) {
+		o.Prefix = &prefix
+	}
+}
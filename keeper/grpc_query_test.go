@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -245,3 +246,96 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryChannelParams() {
+	var req *types.QueryChannelParamsRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		want     types.ChannelParams
+		wantErr  bool
+	}{
+		{
+			name: "channel without params follows the global params",
+			malleate: func() {
+				req = &types.QueryChannelParamsRequest{PortId: types.PortID, ChannelId: "channel-1"}
+			},
+			want:    types.NewChannelParams(types.PortID, "channel-1", true, false),
+			wantErr: false,
+		},
+		{
+			name: "channel with params",
+			malleate: func() {
+				req = &types.QueryChannelParamsRequest{PortId: types.PortID, ChannelId: "channel-0"}
+			},
+			want:    types.NewChannelParams(types.PortID, "channel-0", false, false),
+			wantErr: false,
+		},
+		{
+			name: "invalid channel",
+			malleate: func() {
+				req = &types.QueryChannelParamsRequest{PortId: types.PortID, ChannelId: "(channel)"}
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			err := suite.GetSimApp(suite.chainA).NFTTransferKeeper.SetParams(
+				suite.chainA.GetContext(),
+				types.Params{
+					SendEnabled:    true,
+					ReceiveEnabled: false,
+					ChannelParams:  []types.ChannelParams{types.NewChannelParams(types.PortID, "channel-0", false, true)},
+				},
+			)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+			res, err := suite.queryClient.ChannelParams(suite.chainA.GetContext(), req)
+			if tc.wantErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.want, res.ChannelParams)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	suite.SetupTest() // reset
+
+	ctx := suite.chainA.GetContext()
+	nftTransferKeeper := suite.GetSimApp(suite.chainA).NFTTransferKeeper
+	rateLimits := []types.RateLimit{
+		types.NewRateLimit(types.PortID, "channel-0", "cryptoCat", 10, 0, time.Hour),
+		types.NewRateLimit(types.PortID, "channel-0", "nft-transfer/channel-1/cryptoDog", 0, 5, time.Minute),
+	}
+	for _, rateLimit := range rateLimits {
+		nftTransferKeeper.SaveRateLimit(ctx, rateLimit)
+	}
+
+	res, err := suite.queryClient.RateLimits(ctx, &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(rateLimits, res.RateLimits)
+
+	rateLimitRes, err := suite.queryClient.RateLimit(ctx, &types.QueryRateLimitRequest{
+		PortId:    types.PortID,
+		ChannelId: "channel-0",
+		ClassId:   "nft-transfer/channel-1/cryptoDog",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rateLimits[1], rateLimitRes.RateLimit)
+	suite.Require().Equal(uint64(0), rateLimitRes.Flow.Received)
+
+	_, err = suite.queryClient.RateLimit(ctx, &types.QueryRateLimitRequest{
+		PortId:    types.PortID,
+		ChannelId: "channel-1",
+		ClassId:   "cryptoCat",
+	})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/testing/mock"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestTransferHooks tests that the transfer hooks are called with the transfer
+// info on both chains and that a failing hook aborts the transfer.
+func (suite *KeeperTestSuite) TestTransferHooks() {
+	var (
+		path             *ibctesting.Path
+		classID          = "cryptoCat"
+		errHook          = errors.New("hook failed")
+		sentInfo         types.TransferInfo
+		receivedInfo     types.TransferInfo
+		timeoutTimestamp uint64
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expSend   bool
+		expCallsA map[string]int
+		expCallsB map[string]int
+		expOwnerA bool
+	}{
+		{
+			"success: sent, received and acknowledged",
+			func() {},
+			true,
+			map[string]int{mock.HookBeforeSendTransfer: 1, mock.HookAfterAcknowledgementSuccess: 1},
+			map[string]int{mock.HookAfterReceive: 1},
+			false,
+		},
+		{
+			"send aborted by hook",
+			func() {
+				suite.GetSimApp(suite.chainA).MockTransferHooks.Errors[mock.HookBeforeSendTransfer] = errHook
+			},
+			false,
+			map[string]int{},
+			map[string]int{},
+			true,
+		},
+		{
+			"receive aborted by hook: refunded",
+			func() {
+				suite.GetSimApp(suite.chainB).MockTransferHooks.Errors[mock.HookAfterReceive] = errHook
+			},
+			true,
+			map[string]int{mock.HookBeforeSendTransfer: 1, mock.HookAfterRefund: 1},
+			map[string]int{},
+			true,
+		},
+		{
+			"timeout: refunded",
+			func() {
+				timeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Second).UnixNano())
+			},
+			true,
+			map[string]int{mock.HookBeforeSendTransfer: 1, mock.HookAfterRefund: 1},
+			map[string]int{},
+			true,
+		},
+		{
+			"acknowledgement hook fails: acknowledged",
+			func() {
+				suite.GetSimApp(suite.chainA).MockTransferHooks.Errors[mock.HookAfterAcknowledgementSuccess] = errHook
+			},
+			true,
+			map[string]int{mock.HookBeforeSendTransfer: 1},
+			map[string]int{mock.HookAfterReceive: 1},
+			false,
+		},
+		{
+			"refund hook fails: refunded",
+			func() {
+				timeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Second).UnixNano())
+				suite.GetSimApp(suite.chainA).MockTransferHooks.Errors[mock.HookAfterRefund] = errHook
+			},
+			true,
+			map[string]int{mock.HookBeforeSendTransfer: 1},
+			map[string]int{},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			timeoutTimestamp = 0
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs(classID, "kitty1")
+
+			sentInfo = types.TransferInfo{
+				PortID:         path.EndpointA.ChannelConfig.PortID,
+				ChannelID:      path.EndpointA.ChannelID,
+				ClassTrace:     types.ClassTrace{BaseClassId: classID},
+				VoucherClassID: classID,
+				TokenIDs:       []string{"kitty1"},
+				Sender:         suite.chainA.SenderAccount.GetAddress().String(),
+				Receiver:       suite.chainB.SenderAccount.GetAddress().String(),
+			}
+			receivedInfo = sentInfo
+			receivedInfo.PortID, receivedInfo.ChannelID = path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+			receivedInfo.ClassTrace = sentInfo.ClassTrace.AddPrefix(receivedInfo.PortID, receivedInfo.ChannelID)
+			receivedInfo.VoucherClassID = receivedInfo.ClassTrace.IBCClassID()
+
+			tc.malleate()
+
+			timeoutHeight := suite.chainB.GetTimeoutHeight()
+			if timeoutTimestamp != 0 {
+				timeoutHeight = clienttypes.ZeroHeight()
+			}
+			msg := types.NewMsgTransfer(
+				sentInfo.PortID, sentInfo.ChannelID, classID, sentInfo.TokenIDs,
+				sentInfo.Sender, sentInfo.Receiver, timeoutHeight, timeoutTimestamp, "",
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			if !tc.expSend {
+				suite.Require().ErrorContains(err, errHook.Error())
+			} else {
+				suite.Require().NoError(err)
+				packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+
+				if timeoutTimestamp != 0 {
+					suite.coordinator.IncrementTimeBy(time.Minute)
+					suite.coordinator.CommitBlock(suite.chainB)
+					suite.Require().NoError(path.EndpointA.UpdateClient())
+					suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+				} else {
+					suite.Require().NoError(path.RelayPacket(packet))
+				}
+			}
+
+			hooksA := suite.GetSimApp(suite.chainA).MockTransferHooks
+			hooksB := suite.GetSimApp(suite.chainB).MockTransferHooks
+			suite.Require().Len(hooksA.Calls, len(tc.expCallsA))
+			for hook, count := range tc.expCallsA {
+				suite.Require().Len(hooksA.Calls[hook], count, hook)
+				for _, info := range hooksA.Calls[hook] {
+					suite.Require().Equal(sentInfo, info, hook)
+				}
+			}
+			suite.Require().Len(hooksB.Calls, len(tc.expCallsB))
+			for hook, count := range tc.expCallsB {
+				suite.Require().Len(hooksB.Calls[hook], count, hook)
+				for _, info := range hooksB.Calls[hook] {
+					suite.Require().Equal(receivedInfo, info, hook)
+				}
+			}
+
+			ownerA := suite.GetSimApp(suite.chainA).NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, "kitty1")
+			if tc.expOwnerA {
+				suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), ownerA)
+				suite.Require().False(suite.GetSimApp(suite.chainB).NFTKeeper.HasNFT(suite.chainB.GetContext(), receivedInfo.VoucherClassID, "kitty1"))
+			} else {
+				suite.Require().Equal(types.GetEscrowAddress(sentInfo.PortID, sentInfo.ChannelID), ownerA)
+			}
+		})
+	}
+}
+
+// TestMultiTransferHooks tests that the combined hooks are called in sequence
+// until one of them fails.
+func (suite *KeeperTestSuite) TestMultiTransferHooks() {
+	suite.SetupTest() // reset
+
+	first, second := mock.NewTransferHooks(), mock.NewTransferHooks()
+	hooks := types.NewMultiTransferHooks(first, second)
+	ctx := suite.chainA.GetContext()
+	info := types.TransferInfo{PortID: types.PortID, ChannelID: "channel-0", VoucherClassID: "kitty"}
+
+	suite.Require().NoError(hooks.AfterReceive(ctx, info))
+	suite.Require().Equal([]types.TransferInfo{info}, first.Calls[mock.HookAfterReceive])
+	suite.Require().Equal([]types.TransferInfo{info}, second.Calls[mock.HookAfterReceive])
+
+	first.Errors[mock.HookAfterRefund] = channeltypes.ErrInvalidPacket
+	suite.Require().ErrorIs(hooks.AfterRefund(ctx, info), channeltypes.ErrInvalidPacket)
+	suite.Require().Empty(second.Calls[mock.HookAfterRefund])
+}
+
+// TestResolvedPacketHookFailure tests that an AfterRefund hook which fails,
+// panics or runs out of gas neither fails the timeout of the packet nor the
+// refund of the tokens, and that the failure is reported by an event.
+func (suite *KeeperTestSuite) TestResolvedPacketHookFailure() {
+	testCases := []struct {
+		msg      string
+		malleate func(hooks *mock.TransferHooks)
+		expErr   string
+	}{
+		{
+			"hook fails",
+			func(hooks *mock.TransferHooks) { hooks.Errors[mock.HookAfterRefund] = errors.New("hook failed") },
+			"hook failed",
+		},
+		{
+			"hook panics",
+			func(hooks *mock.TransferHooks) { hooks.Panics[mock.HookAfterRefund] = "hook panicked" },
+			"hook panicked",
+		},
+		{
+			"hook runs out of gas",
+			func(hooks *mock.TransferHooks) {
+				hooks.Gas[mock.HookAfterRefund] = types.ResolvedPacketHookGasLimit + 1
+			},
+			"out of gas",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			classID := "cryptoCat"
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs(classID, "kitty1")
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				classID,
+				[]string{"kitty1"},
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(),
+				0,
+				"",
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			var data types.NonFungibleTokenPacketData
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+
+			app := suite.GetSimApp(suite.chainA)
+			tc.malleate(app.MockTransferHooks)
+			ctx := suite.chainA.GetContext().WithGasMeter(storetypes.NewGasMeter(10 * types.ResolvedPacketHookGasLimit))
+			suite.Require().NoError(app.NFTTransferKeeper.OnTimeoutPacket(ctx, packet, data))
+			suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), app.NFTKeeper.GetOwner(ctx, classID, "kitty1"))
+			suite.Require().Empty(app.MockTransferHooks.Calls[mock.HookAfterRefund])
+			// the gas of the hook is bounded
+			suite.Require().Less(ctx.GasMeter().GasConsumed(), 2*types.ResolvedPacketHookGasLimit)
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeHookFailed {
+					continue
+				}
+				found = true
+				hook, ok := event.GetAttribute(types.AttributeKeyHook)
+				suite.Require().True(ok)
+				suite.Require().Equal(types.HookAfterRefund, hook.Value)
+				sequence, ok := event.GetAttribute(types.AttributeKeySequence)
+				suite.Require().True(ok)
+				suite.Require().Equal(fmt.Sprint(packet.GetSequence()), sequence.Value)
+				hookErr, ok := event.GetAttribute(types.AttributeKeyHookError)
+				suite.Require().True(ok)
+				suite.Require().Contains(hookErr.Value, tc.expErr)
+			}
+			suite.Require().True(found)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// RegisterInvariants registers all nft-transfer invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "class-traces", ClassTracesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "voucher-classes", VoucherClassesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrowed-tokens", EscrowedTokensInvariant(k))
+}
+
+// AllInvariants runs all invariants of the nft-transfer module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			ClassTracesInvariant(k),
+			VoucherClassesInvariant(k),
+			EscrowedTokensInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// ClassTracesInvariant checks that every class trace in the store is valid.
+func ClassTracesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		k.IterateClassTraces(ctx, func(classTrace types.ClassTrace) bool {
+			if err := classTrace.Validate(); err != nil {
+				msg += fmt.Sprintf("\tclass trace %s/%s is invalid: %v\n", classTrace.Path, classTrace.BaseClassId, err)
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "class-traces",
+			fmt.Sprintf("found invalid class traces: %t\n%s", broken, msg)), broken
+	}
+}
+
+// VoucherClassesInvariant checks that every voucher class ibc/{hash} held by the
+// nft keeper has a matching class trace in the store. It requires the NFTKeeper to
+// implement the NFTIterator interface and is skipped otherwise.
+func VoucherClassesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		nftIterator, ok := k.nftKeeper.(types.NFTIterator)
+		if !ok {
+			return sdk.FormatInvariant(types.ModuleName, "voucher-classes",
+				"skipped: nft keeper cannot enumerate the classes\n"), false
+		}
+
+		var (
+			msg    string
+			broken bool
+		)
+
+		nftIterator.IterateClasses(ctx, func(class types.Class) bool {
+			classID := class.GetID()
+			if !strings.HasPrefix(classID, types.ClassPrefix+"/") {
+				return false
+			}
+
+			hash, err := types.ParseHexHash(strings.TrimPrefix(classID, types.ClassPrefix+"/"))
+			if err != nil || !k.HasClassTrace(ctx, hash) {
+				msg += fmt.Sprintf("\tvoucher class %s has no class trace\n", classID)
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "voucher-classes",
+			fmt.Sprintf("found voucher classes without class trace: %t\n%s", broken, msg)), broken
+	}
+}
+
+// EscrowedTokensInvariant checks that the tokens held by the escrow address of
+// each channel belong to classes that are native or came through a different
+// channel, and that they match the escrow index and the escrowed class counts.
+// The tokens held by the escrow addresses are only enumerated if the NFTKeeper
+// implements the NFTIterator interface; otherwise only the tokens of the escrow
+// index are checked.
+func EscrowedTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		checkOrigin := func(portID, channelID, classID, tokenID string) {
+			fullClassPath := classID
+			if strings.HasPrefix(classID, types.ClassPrefix+"/") {
+				var err error
+				if fullClassPath, err = k.ClassPathFromHash(ctx, classID); err != nil {
+					msg += fmt.Sprintf("\tescrowed token %s/%s has no class trace\n", classID, tokenID)
+					broken = true
+					return
+				}
+			}
+			if !types.IsAwayFromOrigin(portID, channelID, fullClassPath) {
+				msg += fmt.Sprintf("\tescrowed token %s/%s came through %s/%s\n", classID, tokenID, portID, channelID)
+				broken = true
+			}
+		}
+
+		counts := make(map[[3]string]uint64)
+		k.IterateEscrowedTokens(ctx, func(token types.EscrowedToken) bool {
+			escrowAddress := types.GetEscrowAddress(token.PortId, token.ChannelId)
+			if !escrowAddress.Equals(k.nftKeeper.GetOwner(ctx, token.ClassId, token.TokenId)) {
+				msg += fmt.Sprintf("\tindexed token %s/%s is not held by the escrow address of %s/%s\n",
+					token.ClassId, token.TokenId, token.PortId, token.ChannelId)
+				broken = true
+			}
+			checkOrigin(token.PortId, token.ChannelId, token.ClassId, token.TokenId)
+			counts[[3]string{token.PortId, token.ChannelId, token.ClassId}]++
+			return false
+		})
+
+		k.IterateEscrowedClassCounts(ctx, func(classCount types.EscrowedClassCount) bool {
+			key := [3]string{classCount.PortId, classCount.ChannelId, classCount.ClassId}
+			if counts[key] != classCount.Count {
+				msg += fmt.Sprintf("\tescrowed count of class %s on %s/%s is %d, expected %d\n",
+					classCount.ClassId, classCount.PortId, classCount.ChannelId, classCount.Count, counts[key])
+				broken = true
+			}
+			delete(counts, key)
+			return false
+		})
+		if len(counts) != 0 {
+			msg += fmt.Sprintf("\t%d escrowed classes have no escrowed count\n", len(counts))
+			broken = true
+		}
+
+		if nftIterator, ok := k.nftKeeper.(types.NFTIterator); ok {
+			portID := k.GetPort(ctx)
+			for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+				if channel.PortId != portID {
+					continue
+				}
+
+				escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+				nftIterator.IterateNFTsOfOwner(ctx, escrowAddress, func(nft types.NFT) bool {
+					token, found := k.GetTokenEscrow(ctx, nft.GetClassID(), nft.GetID())
+					if !found || token.PortId != channel.PortId || token.ChannelId != channel.ChannelId {
+						msg += fmt.Sprintf("\ttoken %s/%s held by the escrow address of %s/%s is not indexed\n",
+							nft.GetClassID(), nft.GetID(), channel.PortId, channel.ChannelId)
+						broken = true
+						// the origin of indexed tokens is already checked above
+						checkOrigin(channel.PortId, channel.ChannelId, nft.GetClassID(), nft.GetID())
+					}
+					return false
+				})
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrowed-tokens",
+			fmt.Sprintf("found inconsistent escrowed tokens: %t\n%s", broken, msg)), broken
+	}
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/nft-transfer/keeper"
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	var (
+		path           *ibctesting.Path
+		classID        = "cryptoCat"
+		voucherClassID string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		// the chain whose invariants are checked
+		chain     func() *ibctesting.TestChain
+		invariant func(k keeper.Keeper) sdk.Invariant
+		expBroken bool
+	}{
+		{
+			"escrowed tokens on the sending chain", func() {},
+			func() *ibctesting.TestChain { return suite.chainA }, keeper.AllInvariants, false,
+		},
+		{
+			"vouchers on the receiving chain", func() {},
+			func() *ibctesting.TestChain { return suite.chainB }, keeper.AllInvariants, false,
+		},
+		{
+			"voucher class without class trace", func() {
+				err := suite.GetSimApp(suite.chainB).NFTKeeper.SaveClass(suite.chainB.GetContext(), nft.Class{
+					Id:   types.ParseClassTrace("nft-transfer/channel-100/" + classID).IBCClassID(),
+					Data: suite.classMetadata,
+				})
+				suite.Require().NoError(err)
+			},
+			func() *ibctesting.TestChain { return suite.chainB }, keeper.VoucherClassesInvariant, true,
+		},
+		{
+			"invalid class trace", func() {
+				suite.GetSimApp(suite.chainB).NFTTransferKeeper.SetClassTrace(suite.chainB.GetContext(), types.ClassTrace{
+					Path: "nft-transfer", BaseClassId: classID,
+				})
+			},
+			func() *ibctesting.TestChain { return suite.chainB }, keeper.ClassTracesInvariant, true,
+		},
+		{
+			"voucher escrowed by the channel it came through", func() {
+				ctx := suite.chainB.GetContext()
+				portID, channelID := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+				err := suite.GetSimApp(suite.chainB).NFTKeeper.Transfer(ctx, voucherClassID, "kitty1", types.GetEscrowAddress(portID, channelID))
+				suite.Require().NoError(err)
+				suite.GetSimApp(suite.chainB).NFTTransferKeeper.SetEscrowedToken(ctx, types.NewEscrowedToken(portID, channelID, voucherClassID, "kitty1"))
+			},
+			func() *ibctesting.TestChain { return suite.chainB }, keeper.EscrowedTokensInvariant, true,
+		},
+		{
+			"token sent straight to the escrow address", func() {
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				_, err := suite.chainA.SendMsgs(&nft.MsgSend{
+					ClassId:  classID,
+					Id:       "kitty2",
+					Sender:   suite.chainA.SenderAccount.GetAddress().String(),
+					Receiver: escrowAddress.String(),
+				})
+				suite.Require().NoError(err)
+			},
+			func() *ibctesting.TestChain { return suite.chainA }, keeper.EscrowedTokensInvariant, true,
+		},
+		{
+			"token sent straight to the escrow address without nft iterator", func() {
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				_, err := suite.chainA.SendMsgs(&nft.MsgSend{
+					ClassId:  classID,
+					Id:       "kitty2",
+					Sender:   suite.chainA.SenderAccount.GetAddress().String(),
+					Receiver: escrowAddress.String(),
+				})
+				suite.Require().NoError(err)
+			},
+			func() *ibctesting.TestChain { return suite.chainA },
+			func(keeper.Keeper) sdk.Invariant {
+				// only the escrow index is checked
+				return keeper.EscrowedTokensInvariant(newKeeperWithoutIterator(suite.GetSimApp(suite.chainA)))
+			},
+			false,
+		},
+		{
+			"voucher sent straight to the escrow address of the channel it came through", func() {
+				escrowAddress := types.GetEscrowAddress(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				_, err := suite.chainB.SendMsgs(&nft.MsgSend{
+					ClassId:  voucherClassID,
+					Id:       "kitty1",
+					Sender:   suite.chainB.SenderAccount.GetAddress().String(),
+					Receiver: escrowAddress.String(),
+				})
+				suite.Require().NoError(err)
+			},
+			func() *ibctesting.TestChain { return suite.chainB }, keeper.EscrowedTokensInvariant, true,
+		},
+		{
+			"indexed token not held by the escrow address", func() {
+				sender := suite.chainA.SenderAccount.GetAddress()
+				err := suite.GetSimApp(suite.chainA).NFTKeeper.Transfer(suite.chainA.GetContext(), classID, "kitty1", sender)
+				suite.Require().NoError(err)
+			},
+			func() *ibctesting.TestChain { return suite.chainA }, keeper.EscrowedTokensInvariant, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs(classID, "kitty1", "kitty2")
+			voucherClassID = types.ParseClassTrace(
+				types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + classID,
+			).IBCClassID()
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				classID,
+				[]string{"kitty1"},
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(),
+				0,
+				"",
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.Require().NoError(path.RelayPacket(packet))
+
+			tc.malleate()
+
+			chain := tc.chain()
+			msgRes, broken := tc.invariant(suite.GetSimApp(chain).NFTTransferKeeper)(chain.GetContext())
+			suite.Require().Equal(tc.expBroken, broken, msgRes)
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
-	storetypes "cosmossdk.io/store/types"
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 
@@ -18,43 +21,156 @@ import (
 
 // Keeper defines the IBC non fungible transfer keeper
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.Codec
+	storeService corestore.KVStoreService
+	cdc          codec.Codec
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
 
 	ics4Wrapper   porttypes.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
+	clientKeeper  types.ClientKeeper
 	portKeeper    types.PortKeeper
 	nftKeeper     types.NFTKeeper
 	authKeeper    types.AccountKeeper
 	scopedKeeper  capabilitykeeper.ScopedKeeper
+
+	hooks          types.TransferHooks
+	circuitBreaker types.CircuitBreaker
+
+	schema               collections.Schema
+	port                 collections.Item[string]
+	classTraces          collections.Map[[]byte, types.ClassTrace]
+	params               collections.Item[types.Params]
+	inFlightPackets      collections.Map[collections.Triple[string, string, uint64], types.InFlightPacket]
+	rateLimits           collections.Map[collections.Triple[string, string, string], types.RateLimit]
+	rateLimitFlows       collections.Map[collections.Triple[string, string, string], types.RateLimitFlow]
+	pendingSendPackets   collections.Map[collections.Triple[string, string, uint64], types.PendingSendPacket]
+	escrowedTokens       collections.Map[collections.Triple[string, string, collections.Pair[string, string]], types.EscrowedToken]
+	tokenEscrows         collections.Map[collections.Pair[string, string], types.EscrowedToken]
+	escrowedClassCounts  collections.Map[collections.Triple[string, string, string], types.EscrowedClassCount]
+	refundRecords        collections.Map[collections.Triple[string, string, uint64], types.RefundRecord]
+	packetSendHeights    collections.Map[collections.Triple[string, string, uint64], types.PacketSendHeight]
+	escrowReleaseRecords collections.Map[uint64, types.EscrowReleaseRecord]
+	nextEscrowReleaseID  collections.Item[uint64]
+	pauses               collections.Map[collections.Triple[string, string, string], types.Pause]
 }
 
-// NewKeeper creates a new IBC nft-transfer Keeper instance
+// NewKeeper creates a new IBC nft-transfer Keeper instance.
+//
+// The nft-transfer version of a channel is read from the channelKeeper rather
+// than from the ics4Wrapper, so it does not depend on the middleware the ics4Wrapper
+// is wired to. The channel version wrapped by the ICS-29 fee middleware is
+// unwrapped, other middleware wrapping the channel version is not supported in
+// the nft-transfer stack.
 func NewKeeper(
 	cdc codec.Codec,
-	key storetypes.StoreKey,
+	storeService corestore.KVStoreService,
 	authority string,
 	ics4Wrapper porttypes.ICS4Wrapper,
 	channelKeeper types.ChannelKeeper,
+	clientKeeper types.ClientKeeper,
 	portKeeper types.PortKeeper,
 	authKeeper types.AccountKeeper,
 	nftKeeper types.NFTKeeper,
 	scopedKeeper capabilitykeeper.ScopedKeeper,
 ) Keeper {
-	return Keeper{
-		storeKey:      key,
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:  storeService,
 		cdc:           cdc,
 		authority:     authority,
 		ics4Wrapper:   ics4Wrapper,
 		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
 		portKeeper:    portKeeper,
 		nftKeeper:     nftKeeper,
 		authKeeper:    authKeeper,
 		scopedKeeper:  scopedKeeper,
+
+		port:        collections.NewItem(sb, collections.NewPrefix(types.PortKey), "port", collections.StringValue),
+		classTraces: collections.NewMap(sb, collections.NewPrefix(types.ClassTraceKey), "class_traces", collections.BytesKey, codec.CollValue[types.ClassTrace](cdc)),
+		params:      collections.NewItem(sb, collections.NewPrefix(types.ParamsKey), "params", codec.CollValue[types.Params](cdc)),
+		inFlightPackets: collections.NewMap(sb, collections.NewPrefix(types.InFlightPacketKey), "in_flight_packets",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key), codec.CollValue[types.InFlightPacket](cdc)),
+		rateLimits: collections.NewMap(sb, collections.NewPrefix(types.RateLimitKey), "rate_limits",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey), codec.CollValue[types.RateLimit](cdc)),
+		rateLimitFlows: collections.NewMap(sb, collections.NewPrefix(types.RateLimitFlowKey), "rate_limit_flows",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey), codec.CollValue[types.RateLimitFlow](cdc)),
+		pendingSendPackets: collections.NewMap(sb, collections.NewPrefix(types.PendingSendPacketKey), "pending_send_packets",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key), codec.CollValue[types.PendingSendPacket](cdc)),
+		escrowedTokens: collections.NewMap(sb, collections.NewPrefix(types.EscrowedTokenKey), "escrowed_tokens",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+			codec.CollValue[types.EscrowedToken](cdc)),
+		tokenEscrows: collections.NewMap(sb, collections.NewPrefix(types.TokenEscrowKey), "token_escrows",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.EscrowedToken](cdc)),
+		escrowedClassCounts: collections.NewMap(sb, collections.NewPrefix(types.EscrowedClassCountKey), "escrowed_class_counts",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey), codec.CollValue[types.EscrowedClassCount](cdc)),
+		refundRecords: collections.NewMap(sb, collections.NewPrefix(types.RefundRecordKey), "refund_records",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key), codec.CollValue[types.RefundRecord](cdc)),
+		packetSendHeights: collections.NewMap(sb, collections.NewPrefix(types.PacketSendHeightKey), "packet_send_heights",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key), codec.CollValue[types.PacketSendHeight](cdc)),
+		escrowReleaseRecords: collections.NewMap(sb, collections.NewPrefix(types.EscrowReleaseRecordKey), "escrow_release_records",
+			collections.Uint64Key, codec.CollValue[types.EscrowReleaseRecord](cdc)),
+		nextEscrowReleaseID: collections.NewItem(sb, collections.NewPrefix(types.NextEscrowReleaseIDKey), "next_escrow_release_id", collections.Uint64Value),
+		pauses: collections.NewMap(sb, collections.NewPrefix(types.PauseKey), "pauses",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey), codec.CollValue[types.Pause](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.schema = schema
+	return k
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after
+// the keepers creation to set the middleware which is above this module
+// in the IBC application stack.
+func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
+// SetHooks sets the transfer hooks. It must be called before the keeper is
+// passed to the IBC module, which holds a copy of the keeper.
+func (k *Keeper) SetHooks(hooks types.TransferHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set nft-transfer hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// SetCircuitBreaker sets the x/circuit breaker which may trip the receipt of
+// packets under types.RecvPacketTypeURL and MsgTransfer under its type URL. It
+// must be called before the keeper is passed to the IBC module, which holds a
+// copy of the keeper.
+func (k *Keeper) SetCircuitBreaker(circuitBreaker types.CircuitBreaker) *Keeper {
+	if k.circuitBreaker != nil {
+		panic("cannot set nft-transfer circuit breaker twice")
+	}
+	k.circuitBreaker = circuitBreaker
+	return k
+}
+
+// Hooks returns the transfer hooks. If no hooks are set, the returned hooks
+// do nothing.
+func (k Keeper) Hooks() types.TransferHooks {
+	if k.hooks == nil {
+		return types.MultiTransferHooks{}
+	}
+	return k.hooks
+}
+
+// GetNFTKeeper returns the nft keeper which the tokens are transferred with.
+func (k Keeper) GetNFTKeeper() types.NFTKeeper {
+	return k.nftKeeper
+}
+
+// GetChannelKeeper returns the IBC channel keeper.
+func (k Keeper) GetChannelKeeper() types.ChannelKeeper {
+	return k.channelKeeper
 }
 
 // Logger returns a module-specific logger.
@@ -64,14 +180,18 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // SetPort sets the portID for the nft-transfer module. Used in InitGenesis
 func (k Keeper) SetPort(ctx sdk.Context, portID string) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.PortKey, []byte(portID))
+	if err := k.port.Set(ctx, portID); err != nil {
+		panic(err)
+	}
 }
 
 // GetPort returns the portID for the nft-transfer module.
 func (k Keeper) GetPort(ctx sdk.Context) string {
-	store := ctx.KVStore(k.storeKey)
-	return string(store.Get(types.PortKey))
+	portID, err := k.port.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	return portID
 }
 
 // IsBound checks if the transfer module is already bound to the desired port
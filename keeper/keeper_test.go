@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/x/nft"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
@@ -83,6 +85,36 @@ func (suite *KeeperTestSuite) GetSimApp(chain *ibctesting.TestChain) *simapp.Sim
 	return app
 }
 
+// mintNFTs mints the given tokens of classID to the sender account of chainA.
+func (suite *KeeperTestSuite) mintNFTs(classID string, nftIDs ...string) {
+	nftKeeper := suite.GetSimApp(suite.chainA).NFTKeeper
+	err := nftKeeper.SaveClass(suite.chainA.GetContext(), nft.Class{
+		Id:   classID,
+		Data: suite.classMetadata,
+	})
+	suite.Require().NoError(err, "SaveClass error")
+	for _, nftID := range nftIDs {
+		err = nftKeeper.Mint(suite.chainA.GetContext(), nft.NFT{
+			ClassId: classID,
+			Id:      nftID,
+			Data:    suite.tokenMetadata,
+		}, suite.chainA.SenderAccount.GetAddress())
+		suite.Require().NoError(err, "Mint error")
+	}
+}
+
+// successAck returns the success acknowledgement written by chainB when receiving
+// the tokens of classID sent from chainA over the path to its sender account.
+func (suite *KeeperTestSuite) successAck(path *ibctesting.Path, classID string) []byte {
+	classTrace := types.ParseClassTrace(
+		types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + classID,
+	)
+	result := types.NewNonFungibleTokenPacketResult(
+		classTrace.IBCClassID(), classTrace.Hash().String(), suite.chainB.SenderAccount.GetAddress().String(),
+	)
+	return result.Acknowledgement().Acknowledgement()
+}
+
 func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 	path := ibctesting.NewPath(chainA, chainB)
 	path.EndpointA.ChannelConfig.PortID = types.PortID
@@ -95,3 +127,13 @@ func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+// TestKeeperTestSuiteDepinject runs the keeper tests against a SimApp whose
+// nft-transfer module is wired through depinject.
+func TestKeeperTestSuiteDepinject(t *testing.T) {
+	appInit := ibctesting.DefaultTestingAppInit
+	ibctesting.DefaultTestingAppInit = ibctesting.SetupTestingAppWithDepinject
+	defer func() { ibctesting.DefaultTestingAppInit = appInit }()
+
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 builds the escrow index from the tokens held by the escrow
+// accounts of the channels bound to the nft-transfer port. The index is only
+// built if the NFTKeeper implements the NFTIterator interface, otherwise the
+// tokens escrowed before the upgrade are left out of it and a warning is logged.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	nftIterator, ok := m.keeper.nftKeeper.(types.NFTIterator)
+	if !ok {
+		m.keeper.Logger(ctx).Warn("nft keeper cannot enumerate the tokens held by the escrow accounts, the escrow index is not built from existing state")
+		return nil
+	}
+
+	portID := m.keeper.GetPort(ctx)
+	for _, channel := range m.keeper.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		if channel.PortId != portID {
+			continue
+		}
+
+		escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		nftIterator.IterateNFTsOfOwner(ctx, escrowAddress, func(nft types.NFT) bool {
+			m.keeper.SetEscrowedToken(ctx, types.NewEscrowedToken(channel.PortId, channel.ChannelId, nft.GetClassID(), nft.GetID()))
+			return false
+		})
+	}
+	return nil
+}
+
+// Migrate2to3 re-keys the stores of the keeper to the layout of its collections.
+// The port, the params, the escrow release records and the id of the next
+// release record keep their layout. The class traces are re-keyed by the hash
+// of their path, and the other entries by the identifiers they carry, which
+// were joined with "/" before. The entries that do not decode are reported as an
+// error.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	k := m.keeper
+
+	if err := rekey(ctx, store, k.classTraces, func(classTrace types.ClassTrace) ([]byte, error) {
+		if err := classTrace.Validate(); err != nil {
+			return nil, err
+		}
+		return classTrace.Hash(), nil
+	}); err != nil {
+		return err
+	}
+	if err := rekey(ctx, store, k.inFlightPackets, func(packet types.InFlightPacket) (collections.Triple[string, string, uint64], error) {
+		return collections.Join3(packet.ForwardPortId, packet.ForwardChannelId, packet.ForwardSequence), nil
+	}); err != nil {
+		return err
+	}
+	if err := rekey(ctx, store, k.rateLimits, func(rateLimit types.RateLimit) (collections.Triple[string, string, string], error) {
+		return collections.Join3(rateLimit.PortId, rateLimit.ChannelId, rateLimit.ClassId), nil
+	}); err != nil {
+		return err
+	}
+	if err := rekey(ctx, store, k.rateLimitFlows, func(flow types.RateLimitFlow) (collections.Triple[string, string, string], error) {
+		return collections.Join3(flow.PortId, flow.ChannelId, flow.ClassId), nil
+	}); err != nil {
+		return err
+	}
+	if err := rekey(ctx, store, k.pendingSendPackets, func(packet types.PendingSendPacket) (collections.Triple[string, string, uint64], error) {
+		return collections.Join3(packet.PortId, packet.ChannelId, packet.Sequence), nil
+	}); err != nil {
+		return err
+	}
+	if err := rekey(ctx, store, k.escrowedTokens, func(token types.EscrowedToken) (escrowedTokenKey, error) {
+		return collections.Join3(token.PortId, token.ChannelId, collections.Join(token.ClassId, token.TokenId)), nil
+	}); err != nil {
+		return err
+	}
+	if err := rekey(ctx, store, k.tokenEscrows, func(token types.EscrowedToken) (collections.Pair[string, string], error) {
+		return collections.Join(token.ClassId, token.TokenId), nil
+	}); err != nil {
+		return err
+	}
+	if err := rekey(ctx, store, k.escrowedClassCounts, func(classCount types.EscrowedClassCount) (collections.Triple[string, string, string], error) {
+		return collections.Join3(classCount.PortId, classCount.ChannelId, classCount.ClassId), nil
+	}); err != nil {
+		return err
+	}
+	if err := rekey(ctx, store, k.refundRecords, func(record types.RefundRecord) (collections.Triple[string, string, uint64], error) {
+		return collections.Join3(record.PortId, record.ChannelId, record.Sequence), nil
+	}); err != nil {
+		return err
+	}
+	if err := rekey(ctx, store, k.packetSendHeights, func(sendHeight types.PacketSendHeight) (collections.Triple[string, string, uint64], error) {
+		return collections.Join3(sendHeight.PortId, sendHeight.ChannelId, sendHeight.Sequence), nil
+	}); err != nil {
+		return err
+	}
+	return rekey(ctx, store, k.pauses, func(pause types.Pause) (collections.Triple[string, string, string], error) {
+		return collections.Join3(pause.PortId, pause.ChannelId, pause.ClassId), nil
+	})
+}
+
+// rekey decodes all the entries under the prefix of the given map, removes them
+// and stores them back under the key returned by getKey. The entries are all
+// read before any of them is written, as the old and the new keys share the
+// prefix of the map.
+func rekey[K, V any](ctx sdk.Context, store storetypes.KVStore, m collections.Map[K, V], getKey func(V) (K, error)) error {
+	var (
+		keys   [][]byte
+		values []V
+	)
+	iterator := storetypes.KVStorePrefixIterator(store, m.GetPrefix())
+	for ; iterator.Valid(); iterator.Next() {
+		value, err := m.ValueCodec().Decode(iterator.Value())
+		if err != nil {
+			iterator.Close()
+			return errorsmod.Wrapf(err, "failed to decode %s at key %X", m.GetName(), iterator.Key())
+		}
+		keys = append(keys, iterator.Key())
+		values = append(values, value)
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	for _, value := range values {
+		key, err := getKey(value)
+		if err != nil {
+			return err
+		}
+		if err := m.Set(ctx, key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/bianjieai/nft-transfer/keeper"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// storeEntry is a raw key/value pair of a store snapshot in testdata.
+type storeEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TestMigrate2to3 tests that the port, the class traces and the params of a
+// store written before the collections were introduced are read back after the
+// migration.
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	suite.SetupTest() // reset
+
+	app := suite.GetSimApp(suite.chainA)
+	nftTransferKeeper := app.NFTTransferKeeper
+	ctx := suite.chainA.GetContext()
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	// replace the state written at genesis with the snapshot
+	for _, prefix := range [][]byte{types.PortKey, types.ClassTraceKey, types.ParamsKey} {
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	bz, err := os.ReadFile("testdata/store_v1.json")
+	suite.Require().NoError(err)
+	var entries []storeEntry
+	suite.Require().NoError(json.Unmarshal(bz, &entries))
+	for _, entry := range entries {
+		key, err := hex.DecodeString(entry.Key)
+		suite.Require().NoError(err)
+		value, err := hex.DecodeString(entry.Value)
+		suite.Require().NoError(err)
+		store.Set(key, value)
+	}
+
+	// a class trace stored under a key other than the hash of its path
+	staleTrace := types.ParseClassTrace("nft-transfer/channel-2/bird")
+	staleKey := append(append([]byte{}, types.ClassTraceKey...), []byte("stale")...)
+	store.Set(staleKey, nftTransferKeeper.MustMarshalClassTrace(staleTrace))
+
+	err = keeper.NewMigrator(nftTransferKeeper).Migrate2to3(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.PortID, nftTransferKeeper.GetPort(ctx))
+
+	params := types.NewParams(true, false)
+	params.ChannelParams = []types.ChannelParams{types.NewChannelParams(types.PortID, "channel-1", false, true)}
+	params.SendDeniedClasses = []string{"kitty"}
+	params.ReceiveAllowedClasses = []string{"nft-transfer/channel-0/dog"}
+	suite.Require().Equal(params, nftTransferKeeper.GetParams(ctx))
+
+	expTraces := []types.ClassTrace{
+		types.ParseClassTrace("nft-transfer/channel-0/cryptoCat"),
+		types.ParseClassTrace("nft-transfer/channel-1/nft-transfer/channel-0/dog"),
+		staleTrace,
+	}
+	suite.Require().ElementsMatch(expTraces, nftTransferKeeper.GetAllClassTraces(ctx))
+	for _, trace := range expTraces {
+		classTrace, found := nftTransferKeeper.GetClassTrace(ctx, trace.Hash())
+		suite.Require().True(found)
+		suite.Require().Equal(trace, classTrace)
+	}
+	suite.Require().False(store.Has(staleKey))
+	suite.Require().True(store.Has(append(append([]byte{}, types.ClassTraceKey...), staleTrace.Hash()...)))
+
+	// the migration is idempotent
+	err = keeper.NewMigrator(nftTransferKeeper).Migrate2to3(ctx)
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(expTraces, nftTransferKeeper.GetAllClassTraces(ctx))
+}
+
+// TestMigrate2to3Rekey tests that the entries stored under the identifiers
+// joined with "/" before the collections were introduced are re-keyed.
+func (suite *KeeperTestSuite) TestMigrate2to3Rekey() {
+	suite.SetupTest() // reset
+
+	app := suite.GetSimApp(suite.chainA)
+	nftTransferKeeper := app.NFTTransferKeeper
+	ctx := suite.chainA.GetContext()
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	cdc := app.AppCodec()
+
+	oldKey := func(prefix []byte, format string, args ...any) []byte {
+		return append(append([]byte{}, prefix...), []byte(fmt.Sprintf(format, args...))...)
+	}
+	lengthPrefixed := func(s string) string {
+		return string(binary.BigEndian.AppendUint32(nil, uint32(len(s)))) + s
+	}
+
+	var (
+		inFlightPacket = types.InFlightPacket{ForwardPortId: types.PortID, ForwardChannelId: "channel-1", ForwardSequence: 1}
+		rateLimit      = types.NewRateLimit(types.PortID, "channel-0", "kitty", 10, 10, time.Hour)
+		flow           = types.NewRateLimitFlow(types.PortID, "channel-0", "kitty", time.Unix(0, 0).UTC())
+		pending        = types.NewPendingSendPacket(types.PortID, "channel-0", 2, "kitty", 1, time.Unix(0, 0).UTC())
+		token          = types.NewEscrowedToken(types.PortID, "channel-0", "nft-transfer/channel-9/kitty", "kitty1")
+		classCount     = types.NewEscrowedClassCount(types.PortID, "channel-0", token.ClassId, 1)
+		refundRecord   = types.NewRefundRecord(types.PortID, "channel-0", 2, suite.chainA.SenderAccount.GetAddress().String())
+		sendHeight     = types.NewPacketSendHeight(types.PortID, "channel-0", 2, 10)
+		pause          = types.NewPause(types.PortID, "channel-0", "")
+	)
+	entries := []struct {
+		key   []byte
+		value []byte
+	}{
+		{oldKey(types.InFlightPacketKey, "%s/%s/%d", types.PortID, "channel-1", 1), cdc.MustMarshal(&inFlightPacket)},
+		{oldKey(types.RateLimitKey, "%s/%s/%s", types.PortID, "channel-0", "kitty"), cdc.MustMarshal(&rateLimit)},
+		{oldKey(types.RateLimitFlowKey, "%s/%s/%s", types.PortID, "channel-0", "kitty"), cdc.MustMarshal(&flow)},
+		{oldKey(types.PendingSendPacketKey, "%s/%s/%d", types.PortID, "channel-0", 2), cdc.MustMarshal(&pending)},
+		{oldKey(types.EscrowedTokenKey, "%s/%s/%s%s", types.PortID, "channel-0", lengthPrefixed(token.ClassId), token.TokenId), cdc.MustMarshal(&token)},
+		{oldKey(types.TokenEscrowKey, "%s%s", lengthPrefixed(token.ClassId), token.TokenId), cdc.MustMarshal(&token)},
+		{oldKey(types.EscrowedClassCountKey, "%s/%s/%s", types.PortID, "channel-0", token.ClassId), cdc.MustMarshal(&classCount)},
+		{oldKey(types.RefundRecordKey, "%s/%s/%d", types.PortID, "channel-0", 2), cdc.MustMarshal(&refundRecord)},
+		{oldKey(types.PacketSendHeightKey, "%s/%s/%d", types.PortID, "channel-0", 2), cdc.MustMarshal(&sendHeight)},
+		{oldKey(types.PauseKey, "%s/%s/%s", types.PortID, "channel-0", ""), cdc.MustMarshal(&pause)},
+	}
+	for _, entry := range entries {
+		store.Set(entry.key, entry.value)
+	}
+
+	err := keeper.NewMigrator(nftTransferKeeper).Migrate2to3(ctx)
+	suite.Require().NoError(err)
+
+	for _, entry := range entries {
+		suite.Require().False(store.Has(entry.key), "old key %X", entry.key)
+	}
+	// the identifiers are joined as collections keys, e.g. the port and the
+	// channel terminated by a zero byte followed by the big endian sequence
+	newRefundKey := append(append([]byte{}, types.RefundRecordKey...), []byte(types.PortID+"\x00channel-0\x00")...)
+	suite.Require().True(store.Has(binary.BigEndian.AppendUint64(newRefundKey, 2)))
+
+	packet, found := nftTransferKeeper.GetInFlightPacket(ctx, types.PortID, "channel-1", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(inFlightPacket, packet)
+	gotRateLimit, found := nftTransferKeeper.GetRateLimit(ctx, types.PortID, "channel-0", "kitty")
+	suite.Require().True(found)
+	suite.Require().Equal(rateLimit, gotRateLimit)
+	gotFlow, found := nftTransferKeeper.GetRateLimitFlow(ctx, types.PortID, "channel-0", "kitty")
+	suite.Require().True(found)
+	suite.Require().Equal(flow, gotFlow)
+	gotPending, found := nftTransferKeeper.GetPendingSendPacket(ctx, types.PortID, "channel-0", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(pending, gotPending)
+	gotToken, found := nftTransferKeeper.GetTokenEscrow(ctx, token.ClassId, token.TokenId)
+	suite.Require().True(found)
+	suite.Require().Equal(token, gotToken)
+	suite.Require().Equal([]types.EscrowedToken{token}, nftTransferKeeper.GetAllEscrowedTokens(ctx))
+	suite.Require().Equal(uint64(1), nftTransferKeeper.GetEscrowedClassCount(ctx, types.PortID, "channel-0", token.ClassId))
+	gotRecord, found := nftTransferKeeper.GetRefundRecord(ctx, types.PortID, "channel-0", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(refundRecord, gotRecord)
+	gotSendHeight, found := nftTransferKeeper.GetPacketSendHeight(ctx, types.PortID, "channel-0", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(sendHeight, gotSendHeight)
+	gotPause, found := nftTransferKeeper.GetPause(ctx, types.PortID, "channel-0", "")
+	suite.Require().True(found)
+	suite.Require().Equal(pause, gotPause)
+
+	// the migrated token leaves the escrow index on deletion
+	nftTransferKeeper.DeleteEscrowedToken(ctx, token.PortId, token.ChannelId, token.ClassId, token.TokenId)
+	suite.Require().Empty(nftTransferKeeper.GetAllEscrowedTokens(ctx))
+	suite.Require().Zero(nftTransferKeeper.GetEscrowedClassCount(ctx, types.PortID, "channel-0", token.ClassId))
+}
@@ -18,12 +18,18 @@ var _ types.MsgServer = Keeper{}
 func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// the message may be nested in another message the circuit breaker of the
+	// router or the ante handler does not check
+	if err := k.validateCircuitAllowed(ctx, sdk.MsgTypeURL(msg)); err != nil {
+		return nil, err
+	}
+
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	sequence, err := k.SendTransfer(
+	sequences, err := k.SendTransfer(
 		ctx, msg.SourcePort, msg.SourceChannel, msg.ClassId, msg.TokenIds,
 		sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo,
 	)
@@ -31,6 +37,12 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return nil, err
 	}
 
+	if msg.RefundAddress != "" {
+		for _, sequence := range sequences {
+			k.SetRefundRecord(ctx, types.NewRefundRecord(msg.SourcePort, msg.SourceChannel, sequence, msg.RefundAddress))
+		}
+	}
+
 	k.Logger(ctx).Info("IBC non-fungible token transfer",
 		"classID", msg.ClassId,
 		"tokenIDs", strings.Join(msg.TokenIds, ","),
@@ -50,7 +62,64 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		),
 	})
 
-	return &types.MsgTransferResponse{Sequence: sequence}, nil
+	return &types.MsgTransferResponse{Sequence: sequences[0], Sequences: sequences}, nil
+}
+
+// BatchTransfer defines a rpc handler method for MsgBatchTransfer.
+func (k Keeper) BatchTransfer(goCtx context.Context, msg *types.MsgBatchTransfer) (*types.MsgBatchTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the batch sends the packets a MsgTransfer would, so that tripping the
+	// circuit breaker for MsgTransfer also stops the batches
+	for _, typeURL := range []string{sdk.MsgTypeURL(msg), sdk.MsgTypeURL(&types.MsgTransfer{})} {
+		if err := k.validateCircuitAllowed(ctx, typeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(types.BatchTransferGasPerToken*msg.TokenCount(), "nft batch transfer")
+
+	sequences, err := k.SendBatchTransfer(
+		ctx, msg.SourcePort, msg.SourceChannel, msg.ClassTokens,
+		sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.RefundAddress != "" {
+		for _, sequence := range sequences {
+			k.SetRefundRecord(ctx, types.NewRefundRecord(msg.SourcePort, msg.SourceChannel, sequence, msg.RefundAddress))
+		}
+	}
+
+	for _, classTokens := range msg.ClassTokens {
+		k.Logger(ctx).Info("IBC non-fungible token transfer",
+			"classID", classTokens.ClassId,
+			"tokenIDs", strings.Join(classTokens.TokenIds, ","),
+			"sender", msg.Sender,
+			"receiver", msg.Receiver,
+		)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgBatchTransferResponse{Sequences: sequences}, nil
 }
 
 // UpdateParams defines a governance operation for updating the nft-transfer module parameters.
@@ -60,9 +129,126 @@ func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
 	}
 
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetRateLimit defines a governance operation for adding or updating the rate limit
+// of a class on a channel. The authority is defined in the keeper.
+func (k Keeper) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.RateLimit.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SaveRateLimit(ctx, msg.RateLimit)
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit defines a governance operation for removing the rate limit of a
+// class on a channel. The authority is defined in the keeper.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, msg.PortId, msg.ChannelId, msg.ClassId); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "port ID (%s) channel ID (%s) class ID (%s)", msg.PortId, msg.ChannelId, msg.ClassId)
+	}
+
+	k.DeleteRateLimit(ctx, msg.PortId, msg.ChannelId, msg.ClassId)
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// MigrateEscrow defines a governance operation for moving the tokens escrowed
+// by an unusable channel to the escrow of the channel replacing it. The
+// authority is defined in the keeper.
+func (k Keeper) MigrateEscrow(goCtx context.Context, msg *types.MsgMigrateEscrow) (*types.MsgMigrateEscrowResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	count, err := k.MigrateEscrowedTokens(ctx, msg.SourcePortId, msg.SourceChannelId, msg.DestinationPortId, msg.DestinationChannelId, msg.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgMigrateEscrowResponse{TokenCount: count}, nil
+}
+
+// ReleaseEscrow defines a governance operation for releasing the tokens
+// escrowed by an unusable channel to their owners. The authority is defined in
+// the keeper.
+func (k Keeper) ReleaseEscrow(goCtx context.Context, msg *types.MsgReleaseEscrow) (*types.MsgReleaseEscrowResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := types.ValidateEscrowReleases(msg.PortId, msg.ChannelId, msg.Releases, msg.Evidence); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := k.ReleaseEscrowedTokens(ctx, msg.PortId, msg.ChannelId, msg.Releases, msg.Evidence)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgReleaseEscrowResponse{RecordId: id}, nil
+}
+
+// Pause defines an operation for pausing the transfers over a channel or of a
+// class. The signer must be the authority defined in the keeper or the
+// guardian of the params.
+func (k Keeper) Pause(goCtx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validatePauseSigner(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Pause.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetPause(ctx, msg.Pause)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPaused{
+		Signer: msg.Signer,
+		Pause:  msg.Pause,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgPauseResponse{}, nil
+}
+
+// Unpause defines an operation for lifting a pause. The signer must be the
+// authority defined in the keeper or the guardian of the params.
+func (k Keeper) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validatePauseSigner(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetPause(ctx, msg.Pause.PortId, msg.Pause.ChannelId, msg.Pause.ClassId); !found {
+		return nil, errorsmod.Wrapf(types.ErrPauseNotFound, "port ID (%s) channel ID (%s) class ID (%s)", msg.Pause.PortId, msg.Pause.ChannelId, msg.Pause.ClassId)
+	}
+
+	k.DeletePause(ctx, msg.Pause.PortId, msg.Pause.ChannelId, msg.Pause.ClassId)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUnpaused{
+		Signer: msg.Signer,
+		Pause:  msg.Pause,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgUnpauseResponse{}, nil
+}
@@ -1,6 +1,13 @@
 package keeper_test
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
 	"github.com/bianjieai/nft-transfer/types"
 )
 
@@ -57,6 +64,46 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			},
 			expErr: false,
 		},
+		{
+			name: "channel and class params",
+			input: &types.MsgUpdateParams{
+				Authority: nftTransferKeeper.GetAuthority(),
+				Params: types.Params{
+					SendEnabled:          true,
+					ReceiveEnabled:       true,
+					ChannelParams:        []types.ChannelParams{types.NewChannelParams(types.PortID, "channel-0", false, true)},
+					SendAllowedClasses:   []string{"cryptoCat"},
+					ReceiveDeniedClasses: []string{"nft-transfer/channel-0/cryptoCat"},
+				},
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid channel params",
+			input: &types.MsgUpdateParams{
+				Authority: nftTransferKeeper.GetAuthority(),
+				Params: types.Params{
+					SendEnabled:    true,
+					ReceiveEnabled: true,
+					ChannelParams:  []types.ChannelParams{types.NewChannelParams(types.PortID, "(channel)", false, true)},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "identifier",
+		},
+		{
+			name: "duplicate class",
+			input: &types.MsgUpdateParams{
+				Authority: nftTransferKeeper.GetAuthority(),
+				Params: types.Params{
+					SendEnabled:       true,
+					ReceiveEnabled:    true,
+					SendDeniedClasses: []string{"cryptoCat", "cryptoCat"},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "duplicate classId",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -75,3 +122,191 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgSetAndRemoveRateLimit() {
+	nftTransferKeeper := suite.GetSimApp(suite.chainA).NFTTransferKeeper
+	authority := nftTransferKeeper.GetAuthority()
+	rateLimit := types.NewRateLimit(types.PortID, "channel-0", "cryptoCat", 10, 5, time.Hour)
+
+	_, err := nftTransferKeeper.SetRateLimit(suite.chainA.GetContext(), types.NewMsgSetRateLimit("invalid", rateLimit))
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	invalid := rateLimit
+	invalid.Window = 0
+	_, err = nftTransferKeeper.SetRateLimit(suite.chainA.GetContext(), types.NewMsgSetRateLimit(authority, invalid))
+	suite.Require().ErrorIs(err, types.ErrInvalidRateLimit)
+
+	_, err = nftTransferKeeper.SetRateLimit(suite.chainA.GetContext(), types.NewMsgSetRateLimit(authority, rateLimit))
+	suite.Require().NoError(err)
+	actual, found := nftTransferKeeper.GetRateLimit(suite.chainA.GetContext(), types.PortID, "channel-0", "cryptoCat")
+	suite.Require().True(found)
+	suite.Require().Equal(rateLimit, actual)
+
+	_, err = nftTransferKeeper.RemoveRateLimit(suite.chainA.GetContext(), types.NewMsgRemoveRateLimit("invalid", types.PortID, "channel-0", "cryptoCat"))
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	_, err = nftTransferKeeper.RemoveRateLimit(suite.chainA.GetContext(), types.NewMsgRemoveRateLimit(authority, types.PortID, "channel-0", "cryptoCat"))
+	suite.Require().NoError(err)
+	_, found = nftTransferKeeper.GetRateLimit(suite.chainA.GetContext(), types.PortID, "channel-0", "cryptoCat")
+	suite.Require().False(found)
+
+	_, err = nftTransferKeeper.RemoveRateLimit(suite.chainA.GetContext(), types.NewMsgRemoveRateLimit(authority, types.PortID, "channel-0", "cryptoCat"))
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+}
+
+// TestMsgBatchTransfer tests that the tokens of several classes are sent in one
+// packet per class, and that none is sent when the transfer of any class fails.
+func (suite *KeeperTestSuite) TestMsgBatchTransfer() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgBatchTransfer
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success with refund address",
+			func() { msg.RefundAddress = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String() },
+			nil,
+		},
+		{
+			"failure: token of the last class not owned by the sender",
+			func() {
+				nftKeeper := suite.GetSimApp(suite.chainA).NFTKeeper
+				suite.Require().NoError(nftKeeper.Transfer(suite.chainA.GetContext(), "cryptoDog", "puppy",
+					suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()))
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: last class paused on the channel",
+			func() {
+				suite.GetSimApp(suite.chainA).NFTTransferKeeper.SetPause(suite.chainA.GetContext(),
+					types.NewPause(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "cryptoDog"))
+			},
+			types.ErrPaused,
+		},
+		{
+			"failure: circuit tripped for MsgTransfer",
+			func() {
+				suite.Require().NoError(suite.GetSimApp(suite.chainA).CircuitKeeper.DisableList.Set(
+					suite.chainA.GetContext(), sdk.MsgTypeURL(&types.MsgTransfer{})))
+			},
+			types.ErrPaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs("cryptoCat", "kitty1", "kitty2")
+			suite.mintNFTs("cryptoDog", "puppy")
+
+			msg = types.NewMsgBatchTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				[]types.ClassTokens{
+					{ClassId: "cryptoCat", TokenIds: []string{"kitty1", "kitty2"}},
+					{ClassId: "cryptoDog", TokenIds: []string{"puppy"}},
+				},
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(),
+				0,
+				"",
+			)
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			nftTransferKeeper := suite.GetSimApp(suite.chainA).NFTTransferKeeper
+			nftKeeper := suite.GetSimApp(suite.chainA).NFTKeeper
+			channelKeeper := suite.GetSimApp(suite.chainA).IBCKeeper.ChannelKeeper
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			gasConsumed := ctx.GasMeter().GasConsumed()
+			res, err := nftTransferKeeper.BatchTransfer(ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+
+				// the tokens of the first class are not sent either
+				suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), nftKeeper.GetOwner(ctx, "cryptoCat", "kitty1"))
+				suite.Require().False(channelKeeper.HasPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal([]uint64{1, 2}, res.Sequences)
+			suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasConsumed, 3*types.BatchTransferGasPerToken)
+
+			for i, classTokens := range msg.ClassTokens {
+				sequence := res.Sequences[i]
+				suite.Require().True(channelKeeper.HasPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence))
+				for _, tokenID := range classTokens.TokenIds {
+					suite.Require().Equal(escrowAddress, nftKeeper.GetOwner(ctx, classTokens.ClassId, tokenID))
+				}
+
+				record, found := nftTransferKeeper.GetRefundRecord(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().Equal(msg.RefundAddress != "", found)
+				if found {
+					suite.Require().Equal(msg.RefundAddress, record.RefundAddress)
+				}
+			}
+		})
+	}
+}
+
+// TestBatchTransferRelay tests that the packets of a MsgBatchTransfer delivered
+// in a transaction are received on the counterparty chain.
+func (suite *KeeperTestSuite) TestBatchTransferRelay() {
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	suite.mintNFTs("cryptoCat", "kitty1", "kitty2")
+	suite.mintNFTs("cryptoDog", "puppy")
+
+	msg := types.NewMsgBatchTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		[]types.ClassTokens{
+			{ClassId: "cryptoCat", TokenIds: []string{"kitty1", "kitty2"}},
+			{ClassId: "cryptoDog", TokenIds: []string{"puppy"}},
+		},
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(),
+		0,
+		"",
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packets, err := ibctesting.ParsePacketsFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Len(packets, len(msg.ClassTokens))
+
+	nftKeeper := suite.GetSimApp(suite.chainB).NFTKeeper
+	for i, packet := range packets {
+		suite.Require().NoError(path.RelayPacket(packet))
+
+		classTrace := types.ParseClassTrace(
+			types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + msg.ClassTokens[i].ClassId,
+		)
+		for _, tokenID := range msg.ClassTokens[i].TokenIds {
+			suite.Require().Equal(suite.chainB.SenderAccount.GetAddress(),
+				nftKeeper.GetOwner(suite.chainB.GetContext(), classTrace.IBCClassID(), tokenID))
+		}
+	}
+}
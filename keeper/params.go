@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"errors"
+
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bianjieai/nft-transfer/types"
@@ -16,28 +20,24 @@ func (k Keeper) GetReceiveEnabled(ctx sdk.Context) bool {
 	return k.GetParams(ctx).ReceiveEnabled
 }
 
+// GetChannelParams retrieves the effective send and receive enabled booleans of
+// the given channel from the paramstore
+func (k Keeper) GetChannelParams(ctx sdk.Context, portID, channelID string) types.ChannelParams {
+	return k.GetParams(ctx).EffectiveChannelParams(portID, channelID)
+}
+
 // GetParams returns the total set of ibc-transfer parameters.
-func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ParamsKey)
-	if bz == nil {
-		return params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params, err := k.params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
 	}
-
-	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
 // SetParams sets the total set of ibc-transfer parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
-	store := ctx.KVStore(k.storeKey)
-	bz, err := k.cdc.Marshal(&params)
-	if err != nil {
-		return err
-	}
-	store.Set(types.ParamsKey, bz)
-
-	return nil
+	return k.params.Set(ctx, params)
 }
 
 // GetAuthority returns the nft-transfer module's authority.
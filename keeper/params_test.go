@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/nft"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestChannelAndClassParams tests that the channel and class params are enforced
+// when sending from chainA and receiving on chainB.
+func (suite *KeeperTestSuite) TestChannelAndClassParams() {
+	var (
+		path    *ibctesting.Path
+		classID = "cryptoCat"
+		nftID   = "kitty"
+	)
+
+	voucherClassID := func() string {
+		return types.ParseClassTrace(
+			types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + classID,
+		).IBCClassID()
+	}
+	setParams := func(chain *ibctesting.TestChain, params types.Params) {
+		params.SendEnabled = true
+		params.ReceiveEnabled = true
+		err := suite.GetSimApp(chain).NFTTransferKeeper.SetParams(chain.GetContext(), params)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		// expected error on send, if any
+		sendErr error
+		// expected error acknowledgement on receive, if any
+		recvErr error
+	}{
+		{
+			"success with default params", func() {}, nil, nil,
+		},
+		{
+			"send disabled on channel", func() {
+				setParams(suite.chainA, types.Params{
+					ChannelParams: []types.ChannelParams{
+						types.NewChannelParams(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, false, true),
+					},
+				})
+			}, types.ErrChannelSendDisabled, nil,
+		},
+		{
+			"send enabled on another channel only", func() {
+				setParams(suite.chainA, types.Params{
+					ChannelParams: []types.ChannelParams{
+						types.NewChannelParams(path.EndpointA.ChannelConfig.PortID, "channel-100", false, false),
+					},
+				})
+			}, nil, nil,
+		},
+		{
+			"class not in send allow list", func() {
+				setParams(suite.chainA, types.Params{SendAllowedClasses: []string{"otherClass"}})
+			}, types.ErrClassSendDisabled, nil,
+		},
+		{
+			"class in send allow list", func() {
+				setParams(suite.chainA, types.Params{SendAllowedClasses: []string{classID}})
+			}, nil, nil,
+		},
+		{
+			"class in send deny list", func() {
+				setParams(suite.chainA, types.Params{SendDeniedClasses: []string{classID}})
+			}, types.ErrClassSendDisabled, nil,
+		},
+		{
+			"receive disabled on channel", func() {
+				setParams(suite.chainB, types.Params{
+					ChannelParams: []types.ChannelParams{
+						types.NewChannelParams(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, true, false),
+					},
+				})
+			}, nil, types.ErrChannelReceiveDisabled,
+		},
+		{
+			"voucher class in receive deny list", func() {
+				setParams(suite.chainB, types.Params{ReceiveDeniedClasses: []string{voucherClassID()}})
+			}, nil, types.ErrClassReceiveDisabled,
+		},
+		{
+			"voucher class not in receive allow list", func() {
+				setParams(suite.chainB, types.Params{ReceiveAllowedClasses: []string{"nft-transfer/channel-100/" + classID}})
+			}, nil, types.ErrClassReceiveDisabled,
+		},
+		{
+			"voucher class path in receive allow list", func() {
+				setParams(suite.chainB, types.Params{ReceiveAllowedClasses: []string{
+					types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + classID,
+				}})
+			}, nil, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			nftKeeper := suite.GetSimApp(suite.chainA).NFTKeeper
+			err := nftKeeper.SaveClass(suite.chainA.GetContext(), nft.Class{
+				Id:   classID,
+				Data: suite.classMetadata,
+			})
+			suite.Require().NoError(err, "SaveClass error")
+			err = nftKeeper.Mint(suite.chainA.GetContext(), nft.NFT{
+				ClassId: classID,
+				Id:      nftID,
+				Data:    suite.tokenMetadata,
+			}, sender)
+			suite.Require().NoError(err, "Mint error")
+
+			tc.malleate()
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				classID,
+				[]string{nftID},
+				sender.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(),
+				0,
+				"",
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			if tc.sendErr != nil {
+				suite.Require().ErrorContains(err, tc.sendErr.Error())
+				suite.Require().Equal(sender, nftKeeper.GetOwner(suite.chainA.GetContext(), classID, nftID))
+				return
+			}
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			_, ack, err := path.RelayPacketWithResults(packet)
+			suite.Require().NoError(err)
+
+			if tc.recvErr != nil {
+				suite.Require().Equal(channeltypes.NewErrorAcknowledgement(tc.recvErr).Acknowledgement(), ack)
+				suite.Require().Equal(sender, nftKeeper.GetOwner(suite.chainA.GetContext(), classID, nftID))
+				suite.Require().False(suite.GetSimApp(suite.chainB).NFTKeeper.HasNFT(suite.chainB.GetContext(), voucherClassID(), nftID))
+				return
+			}
+
+			suite.Require().Equal(suite.successAck(path, classID), ack)
+			suite.Require().Equal(
+				suite.chainB.SenderAccount.GetAddress(),
+				suite.GetSimApp(suite.chainB).NFTKeeper.GetOwner(suite.chainB.GetContext(), voucherClassID(), nftID),
+			)
+		})
+	}
+}
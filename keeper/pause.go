@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// GetPause returns the pause of the given class on the given port and channel,
+// either of which may be empty.
+func (k Keeper) GetPause(ctx sdk.Context, portID, channelID, classID string) (types.Pause, bool) {
+	pause, err := k.pauses.Get(ctx, collections.Join3(portID, channelID, classID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Pause{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return pause, true
+}
+
+// SetPause stores the pause.
+func (k Keeper) SetPause(ctx sdk.Context, pause types.Pause) {
+	if err := k.pauses.Set(ctx, collections.Join3(pause.PortId, pause.ChannelId, pause.ClassId), pause); err != nil {
+		panic(err)
+	}
+}
+
+// DeletePause removes the pause of the given class on the given port and
+// channel.
+func (k Keeper) DeletePause(ctx sdk.Context, portID, channelID, classID string) {
+	if err := k.pauses.Remove(ctx, collections.Join3(portID, channelID, classID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllPauses returns all the pauses.
+func (k Keeper) GetAllPauses(ctx sdk.Context) []types.Pause {
+	pauses := []types.Pause{}
+	err := k.pauses.Walk(ctx, nil, func(_ collections.Triple[string, string, string], pause types.Pause) (bool, error) {
+		pauses = append(pauses, pause)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return pauses
+}
+
+// validateNotPaused checks that the transfers of the class identified by its
+// local class id and its full class path are not paused over the given port
+// and channel. Like the class lists of the params, a class is paused by its
+// base class id, its full class path or its local class id.
+func (k Keeper) validateNotPaused(ctx sdk.Context, portID, channelID, classID, fullClassPath string) error {
+	if _, found := k.GetPause(ctx, portID, channelID, ""); found {
+		return errorsmod.Wrapf(types.ErrPaused, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	for _, id := range []string{classID, fullClassPath, types.ParseClassTrace(fullClassPath).BaseClassId} {
+		if _, found := k.GetPause(ctx, "", "", id); found {
+			return errorsmod.Wrapf(types.ErrPaused, "class %s", id)
+		}
+		if _, found := k.GetPause(ctx, portID, channelID, id); found {
+			return errorsmod.Wrapf(types.ErrPaused, "port ID (%s) channel ID (%s) class %s", portID, channelID, id)
+		}
+	}
+	return nil
+}
+
+// validateCircuitAllowed checks that the x/circuit breaker, if any, is not
+// tripped for the given type URL.
+func (k Keeper) validateCircuitAllowed(ctx sdk.Context, typeURL string) error {
+	if k.circuitBreaker == nil {
+		return nil
+	}
+
+	allowed, err := k.circuitBreaker.IsAllowed(ctx, typeURL)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errorsmod.Wrapf(types.ErrPaused, "circuit breaker tripped for %s", typeURL)
+	}
+	return nil
+}
+
+// validatePauseSigner checks that the signer is the authority or the guardian
+// of the params.
+func (k Keeper) validatePauseSigner(ctx sdk.Context, signer string) error {
+	if signer == k.GetAuthority() {
+		return nil
+	}
+	if guardian := k.GetParams(ctx).Guardian; guardian != "" && signer == guardian {
+		return nil
+	}
+	return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid signer; expected authority or guardian, got %s", signer)
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestMsgPauseAndUnpause tests that a pause may be set and lifted by the
+// authority or the guardian of the params only.
+func (suite *KeeperTestSuite) TestMsgPauseAndUnpause() {
+	suite.SetupTest() // reset
+
+	ctx := suite.chainA.GetContext()
+	nftTransferKeeper := suite.GetSimApp(suite.chainA).NFTTransferKeeper
+	authority := nftTransferKeeper.GetAuthority()
+	guardian := suite.chainA.SenderAccount.GetAddress().String()
+	pause := types.NewPause(types.PortID, "channel-0", "")
+
+	_, err := nftTransferKeeper.Pause(ctx, types.NewMsgPause(guardian, pause))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	params := nftTransferKeeper.GetParams(ctx)
+	params.Guardian = guardian
+	suite.Require().NoError(nftTransferKeeper.SetParams(ctx, params))
+
+	_, err = nftTransferKeeper.Pause(ctx, types.NewMsgPause(guardian, types.NewPause(types.PortID, "", "")))
+	suite.Require().ErrorIs(err, types.ErrInvalidPause)
+
+	_, err = nftTransferKeeper.Pause(ctx, types.NewMsgPause(guardian, pause))
+	suite.Require().NoError(err)
+	_, err = nftTransferKeeper.Pause(ctx, types.NewMsgPause(authority, types.NewPause("", "", "cryptoCat")))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.Pauses(ctx, &types.QueryPausesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.Pause{pause, types.NewPause("", "", "cryptoCat")}, res.Pauses)
+	suite.Require().Contains(suite.typedEvents(ctx.EventManager().ABCIEvents()), &types.EventPaused{Signer: guardian, Pause: pause})
+
+	other := sdk.AccAddress([]byte("other_______________")).String()
+	_, err = nftTransferKeeper.Unpause(ctx, types.NewMsgUnpause(other, pause))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = nftTransferKeeper.Unpause(ctx, types.NewMsgUnpause(authority, pause))
+	suite.Require().NoError(err)
+	_, found := nftTransferKeeper.GetPause(ctx, types.PortID, "channel-0", "")
+	suite.Require().False(found)
+	suite.Require().Contains(suite.typedEvents(ctx.EventManager().ABCIEvents()), &types.EventUnpaused{Signer: authority, Pause: pause})
+
+	_, err = nftTransferKeeper.Unpause(ctx, types.NewMsgUnpause(guardian, pause))
+	suite.Require().ErrorIs(err, types.ErrPauseNotFound)
+}
+
+// TestPausedTransfer tests that paused channels and classes, and the tripped
+// circuit breaker, stop the sending of tokens and make the receipt of tokens
+// fail with an error acknowledgement, while the tokens of the packets in flight
+// are still refunded on acknowledgement and timeout.
+func (suite *KeeperTestSuite) TestPausedTransfer() {
+	var (
+		path    *ibctesting.Path
+		classID = "cryptoCat"
+	)
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		timeout    bool
+		expSendErr bool
+		expRecvErr bool
+	}{
+		{
+			"channel paused on both chains",
+			func() {
+				suite.GetSimApp(suite.chainA).NFTTransferKeeper.SetPause(suite.chainA.GetContext(),
+					types.NewPause(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ""))
+				suite.GetSimApp(suite.chainB).NFTTransferKeeper.SetPause(suite.chainB.GetContext(),
+					types.NewPause(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, ""))
+			},
+			false, true, true,
+		},
+		{
+			"channel paused on the sending chain, packet timed out",
+			func() {
+				suite.GetSimApp(suite.chainA).NFTTransferKeeper.SetPause(suite.chainA.GetContext(),
+					types.NewPause(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ""))
+			},
+			true, true, false,
+		},
+		{
+			"class paused on the sending chain",
+			func() {
+				suite.GetSimApp(suite.chainA).NFTTransferKeeper.SetPause(suite.chainA.GetContext(),
+					types.NewPause(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, classID))
+			},
+			false, true, false,
+		},
+		{
+			"base class paused on all the channels of the receiving chain",
+			func() {
+				suite.GetSimApp(suite.chainB).NFTTransferKeeper.SetPause(suite.chainB.GetContext(),
+					types.NewPause("", "", classID))
+			},
+			false, false, true,
+		},
+		{
+			"class paused on another channel",
+			func() {
+				suite.GetSimApp(suite.chainA).NFTTransferKeeper.SetPause(suite.chainA.GetContext(),
+					types.NewPause(path.EndpointA.ChannelConfig.PortID, "channel-9", classID))
+			},
+			false, false, false,
+		},
+		{
+			"circuit tripped for MsgTransfer",
+			func() {
+				suite.Require().NoError(suite.GetSimApp(suite.chainA).CircuitKeeper.DisableList.Set(
+					suite.chainA.GetContext(), sdk.MsgTypeURL(&types.MsgTransfer{})))
+			},
+			false, true, false,
+		},
+		{
+			"circuit tripped for the receipt of packets",
+			func() {
+				suite.Require().NoError(suite.GetSimApp(suite.chainB).CircuitKeeper.DisableList.Set(
+					suite.chainB.GetContext(), types.RecvPacketTypeURL))
+			},
+			false, false, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs(classID, "kitty1", "kitty2")
+
+			newMsg := func(tokenID string) *types.MsgTransfer {
+				return types.NewMsgTransfer(
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					classID,
+					[]string{tokenID},
+					suite.chainA.SenderAccount.GetAddress().String(),
+					suite.chainB.SenderAccount.GetAddress().String(),
+					clienttypes.ZeroHeight(),
+					uint64(suite.chainB.GetContext().BlockTime().Add(time.Second*30).UnixNano()),
+					"",
+				)
+			}
+
+			// the packet is in flight when the transfers are paused
+			res, err := suite.chainA.SendMsgs(newMsg("kitty1"))
+			suite.Require().NoError(err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			// the msg server is called directly as the circuit breaker of the ante
+			// handler would reject the transaction before it is executed
+			_, err = suite.GetSimApp(suite.chainA).NFTTransferKeeper.Transfer(suite.chainA.GetContext(), newMsg("kitty2"))
+			if tc.expSendErr {
+				suite.Require().ErrorIs(err, types.ErrPaused)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			if tc.timeout {
+				suite.coordinator.IncrementTimeBy(time.Minute)
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+			} else {
+				suite.Require().NoError(path.EndpointB.UpdateClient())
+				res, err := path.EndpointB.RecvPacketWithResult(packet)
+				suite.Require().NoError(err)
+				ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRecvErr, isErrorAck(ack))
+
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ack))
+			}
+
+			expOwner := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			if tc.timeout || tc.expRecvErr {
+				expOwner = suite.chainA.SenderAccount.GetAddress()
+			}
+			suite.Require().Equal(expOwner, suite.GetSimApp(suite.chainA).NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, "kitty1"))
+		})
+	}
+}
+
+// isErrorAck reports whether the acknowledgement is an error acknowledgement.
+func isErrorAck(bz []byte) bool {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return false
+	}
+	return !ack.Success()
+}
@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// checkSendRateLimit counts the tokens of the given class sent over the given
+// channel against its rate limit, if any. It returns an error if the quota of the
+// current window is exceeded, in which case the transfer must be rejected.
+func (k Keeper) checkSendRateLimit(ctx sdk.Context, portID, channelID, classID string, count uint64) (types.RateLimitFlow, bool, error) {
+	rateLimit, found := k.GetRateLimit(ctx, portID, channelID, classID)
+	if !found {
+		return types.RateLimitFlow{}, false, nil
+	}
+
+	flow := k.getCurrentRateLimitFlow(ctx, rateLimit)
+	if err := flow.AddSent(rateLimit, ctx.BlockTime(), count); err != nil {
+		return types.RateLimitFlow{}, false, err
+	}
+	k.SetRateLimitFlow(ctx, flow)
+	return flow, true, nil
+}
+
+// checkReceiveRateLimit counts the tokens received with the given packet against
+// the rate limit of their class on the receiving channel, if any. It returns an
+// error if the quota of the current window is exceeded.
+func (k Keeper) checkReceiveRateLimit(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	classID, err := k.GetReceivedClassID(ctx, packet, data)
+	if err != nil {
+		return err
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, packet.GetDestPort(), packet.GetDestChannel(), classID)
+	if !found {
+		return nil
+	}
+
+	flow := k.getCurrentRateLimitFlow(ctx, rateLimit)
+	if err := flow.AddReceived(rateLimit, ctx.BlockTime(), uint64(len(data.TokenIds))); err != nil {
+		return err
+	}
+	k.SetRateLimitFlow(ctx, flow)
+	return nil
+}
+
+// releaseSendRateLimit gives back the quota taken by the given packet once it
+// is refunded. The quota is only released if the packet was sent within the
+// current or the previous window of the rate limit.
+func (k Keeper) releaseSendRateLimit(ctx sdk.Context, packet channeltypes.Packet, refunded bool) {
+	pending, found := k.GetPendingSendPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+	k.DeletePendingSendPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !refunded {
+		return
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, pending.PortId, pending.ChannelId, pending.ClassId)
+	if !found {
+		return
+	}
+	flow := k.getCurrentRateLimitFlow(ctx, rateLimit)
+	flow.ReleaseSent(rateLimit, pending.WindowStart, pending.TokenCount)
+	k.SetRateLimitFlow(ctx, flow)
+}
+
+// getCurrentRateLimitFlow returns the flow of the given rate limit, advanced to
+// the window containing the current block time.
+func (k Keeper) getCurrentRateLimitFlow(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitFlow {
+	now := ctx.BlockTime()
+	flow, found := k.GetRateLimitFlow(ctx, rateLimit.PortId, rateLimit.ChannelId, rateLimit.ClassId)
+	if !found {
+		return types.NewRateLimitFlow(rateLimit.PortId, rateLimit.ChannelId, rateLimit.ClassId, now)
+	}
+	return flow.Advance(rateLimit, now)
+}
+
+// GetRateLimit returns the rate limit of the given class on the given port and channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, portID, channelID, classID string) (types.RateLimit, bool) {
+	rateLimit, err := k.rateLimits.Get(ctx, collections.Join3(portID, channelID, classID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RateLimit{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return rateLimit, true
+}
+
+// SaveRateLimit stores the rate limit under its port, channel and class.
+func (k Keeper) SaveRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	if err := k.rateLimits.Set(ctx, collections.Join3(rateLimit.PortId, rateLimit.ChannelId, rateLimit.ClassId), rateLimit); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteRateLimit removes the rate limit of the given class on the given port
+// and channel together with its flow.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, portID, channelID, classID string) {
+	key := collections.Join3(portID, channelID, classID)
+	if err := k.rateLimits.Remove(ctx, key); err != nil {
+		panic(err)
+	}
+	if err := k.rateLimitFlows.Remove(ctx, key); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllRateLimits returns all the rate limits.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	err := k.rateLimits.Walk(ctx, nil, func(_ collections.Triple[string, string, string], rateLimit types.RateLimit) (bool, error) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rateLimits
+}
+
+// GetRateLimitFlow returns the flow of the rate limit of the given class on the
+// given port and channel.
+func (k Keeper) GetRateLimitFlow(ctx sdk.Context, portID, channelID, classID string) (types.RateLimitFlow, bool) {
+	flow, err := k.rateLimitFlows.Get(ctx, collections.Join3(portID, channelID, classID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RateLimitFlow{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return flow, true
+}
+
+// SetRateLimitFlow stores the flow under its port, channel and class.
+func (k Keeper) SetRateLimitFlow(ctx sdk.Context, flow types.RateLimitFlow) {
+	if err := k.rateLimitFlows.Set(ctx, collections.Join3(flow.PortId, flow.ChannelId, flow.ClassId), flow); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllRateLimitFlows returns all the flows of the rate limits.
+func (k Keeper) GetAllRateLimitFlows(ctx sdk.Context) []types.RateLimitFlow {
+	flows := []types.RateLimitFlow{}
+	err := k.rateLimitFlows.Walk(ctx, nil, func(_ collections.Triple[string, string, string], flow types.RateLimitFlow) (bool, error) {
+		flows = append(flows, flow)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return flows
+}
+
+// GetPendingSendPacket returns the pending packet sent with the given port, channel and sequence.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	packet, err := k.pendingSendPackets.Get(ctx, collections.Join3(portID, channelID, sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.PendingSendPacket{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return packet, true
+}
+
+// SetPendingSendPacket stores the pending packet under its port, channel and sequence.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	if err := k.pendingSendPackets.Set(ctx, collections.Join3(packet.PortId, packet.ChannelId, packet.Sequence), packet); err != nil {
+		panic(err)
+	}
+}
+
+// DeletePendingSendPacket removes the pending packet sent with the given port, channel and sequence.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	if err := k.pendingSendPackets.Remove(ctx, collections.Join3(portID, channelID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllPendingSendPackets returns all the pending packets.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	packets := []types.PendingSendPacket{}
+	err := k.pendingSendPackets.Walk(ctx, nil, func(_ collections.Triple[string, string, uint64], packet types.PendingSendPacket) (bool, error) {
+		packets = append(packets, packet)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return packets
+}
@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestSendRateLimit tests that the tokens sent over a channel are counted against
+// the rate limit of their class and that refunded tokens release their quota.
+func (suite *KeeperTestSuite) TestSendRateLimit() {
+	var (
+		path    *ibctesting.Path
+		classID = "cryptoCat"
+	)
+
+	transfer := func(timeoutTimestamp uint64, nftIDs ...string) (channeltypes.Packet, error) {
+		timeoutHeight := suite.chainB.GetTimeoutHeight()
+		if timeoutTimestamp != 0 {
+			timeoutHeight = clienttypes.ZeroHeight()
+		}
+		msg := types.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			classID,
+			nftIDs,
+			suite.chainA.SenderAccount.GetAddress().String(),
+			suite.chainB.SenderAccount.GetAddress().String(),
+			timeoutHeight,
+			timeoutTimestamp,
+			"",
+		)
+		res, err := suite.chainA.SendMsgs(msg)
+		if err != nil {
+			return channeltypes.Packet{}, err
+		}
+		return ibctesting.ParsePacketFromEvents(res.GetEvents())
+	}
+	sentFlow := func() uint64 {
+		flow, found := suite.GetSimApp(suite.chainA).NFTTransferKeeper.GetRateLimitFlow(
+			suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, classID,
+		)
+		suite.Require().True(found)
+		return flow.Sent
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"quota exceeded within the window", func() {
+				_, err := transfer(0, "kitty1", "kitty2")
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(2), sentFlow())
+
+				_, err = transfer(0, "kitty3")
+				suite.Require().ErrorContains(err, types.ErrRateLimitExceeded.Error())
+				suite.Require().Equal(uint64(2), sentFlow())
+			},
+		},
+		{
+			"quota not reset across the boundary of the window", func() {
+				_, err := transfer(0, "kitty1", "kitty2")
+				suite.Require().NoError(err)
+
+				suite.coordinator.IncrementTimeBy(time.Hour)
+				_, err = transfer(0, "kitty3")
+				suite.Require().ErrorContains(err, types.ErrRateLimitExceeded.Error())
+			},
+		},
+		{
+			"quota restored once the rolling window has passed", func() {
+				_, err := transfer(0, "kitty1", "kitty2")
+				suite.Require().NoError(err)
+
+				suite.coordinator.IncrementTimeBy(2 * time.Hour)
+				_, err = transfer(0, "kitty3")
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), sentFlow())
+			},
+		},
+		{
+			"quota released on timeout", func() {
+				timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Second).UnixNano())
+				packet, err := transfer(timeoutTimestamp, "kitty1", "kitty2")
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(2), sentFlow())
+
+				suite.coordinator.IncrementTimeBy(time.Minute)
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+				suite.Require().Equal(uint64(0), sentFlow())
+
+				_, found := suite.GetSimApp(suite.chainA).NFTTransferKeeper.GetPendingSendPacket(
+					suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+				)
+				suite.Require().False(found)
+
+				_, err = transfer(0, "kitty1", "kitty2")
+				suite.Require().NoError(err)
+			},
+		},
+		{
+			"quota kept on success acknowledgement", func() {
+				packet, err := transfer(0, "kitty1")
+				suite.Require().NoError(err)
+
+				_, ack, err := path.RelayPacketWithResults(packet)
+				suite.Require().NoError(err)
+				suite.Require().Equal(suite.successAck(path, classID), ack)
+				suite.Require().Equal(uint64(1), sentFlow())
+
+				_, found := suite.GetSimApp(suite.chainA).NFTTransferKeeper.GetPendingSendPacket(
+					suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+				)
+				suite.Require().False(found)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.mintNFTs(classID, "kitty1", "kitty2", "kitty3")
+			suite.GetSimApp(suite.chainA).NFTTransferKeeper.SaveRateLimit(suite.chainA.GetContext(), types.NewRateLimit(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, classID, 2, 0, time.Hour,
+			))
+
+			tc.malleate()
+		})
+	}
+}
+
+// TestReceiveRateLimit tests that the tokens received over a channel are counted
+// against the rate limit of their voucher class and that a packet exceeding the
+// quota is refunded on the sending chain.
+func (suite *KeeperTestSuite) TestReceiveRateLimit() {
+	suite.SetupTest() // reset
+
+	classID := "cryptoCat"
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	suite.mintNFTs(classID, "kitty1", "kitty2", "kitty3")
+
+	voucherClassID := types.ParseClassTrace(
+		types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + classID,
+	).IBCClassID()
+	keeperA := suite.GetSimApp(suite.chainA).NFTTransferKeeper
+	keeperB := suite.GetSimApp(suite.chainB).NFTTransferKeeper
+	keeperA.SaveRateLimit(suite.chainA.GetContext(), types.NewRateLimit(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, classID, 3, 0, time.Hour,
+	))
+	keeperB.SaveRateLimit(suite.chainB.GetContext(), types.NewRateLimit(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucherClassID, 0, 1, time.Hour,
+	))
+
+	transfer := func(nftIDs ...string) []byte {
+		msg := types.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			classID,
+			nftIDs,
+			suite.chainA.SenderAccount.GetAddress().String(),
+			suite.chainB.SenderAccount.GetAddress().String(),
+			suite.chainB.GetTimeoutHeight(),
+			0,
+			"",
+		)
+		res, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		suite.Require().NoError(err)
+
+		_, ack, err := path.RelayPacketWithResults(packet)
+		suite.Require().NoError(err)
+		return ack
+	}
+
+	// the packet exceeds the receive quota of chainB and is refunded on chainA,
+	// which also releases the send quota of chainA
+	ack := transfer("kitty1", "kitty2")
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrRateLimitExceeded).Acknowledgement(), ack)
+	suite.Require().Equal(
+		suite.chainA.SenderAccount.GetAddress(),
+		suite.GetSimApp(suite.chainA).NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, "kitty1"),
+	)
+	flowA, found := keeperA.GetRateLimitFlow(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, classID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(0), flowA.Sent)
+	_, found = keeperB.GetRateLimitFlow(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucherClassID)
+	suite.Require().False(found)
+
+	// a single token fits within the receive quota of chainB
+	ack = transfer("kitty1")
+	suite.Require().Equal(suite.successAck(path, classID), ack)
+	suite.Require().Equal(
+		suite.chainB.SenderAccount.GetAddress(),
+		suite.GetSimApp(suite.chainB).NFTKeeper.GetOwner(suite.chainB.GetContext(), voucherClassID, "kitty1"),
+	)
+	flowB, found := keeperB.GetRateLimitFlow(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucherClassID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), flowB.Received)
+
+	// the quota of chainB is used up for the current window
+	ack = transfer("kitty2")
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrRateLimitExceeded).Acknowledgement(), ack)
+	suite.Require().False(suite.GetSimApp(suite.chainB).NFTKeeper.HasNFT(suite.chainB.GetContext(), voucherClassID, "kitty2"))
+}
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// MigrateEscrowedTokens moves the tokens held by the escrow account of an unusable
+// channel, optionally restricted to a class, to the escrow account of the open
+// channel of the nft-transfer port replacing it and returns the number of tokens
+// moved. It is up to the authority to check that both channels lead to the same
+// counterparty chain.
+func (k Keeper) MigrateEscrowedTokens(ctx sdk.Context, sourcePort, sourceChannel, destinationPort, destinationChannel, classID string) (uint64, error) {
+	if sourcePort == destinationPort && sourceChannel == destinationChannel {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "source and destination channels cannot be the same")
+	}
+	if boundPort := k.GetPort(ctx); destinationPort != boundPort {
+		return 0, errorsmod.Wrapf(porttypes.ErrInvalidPort, "invalid destination port: %s, expected %s", destinationPort, boundPort)
+	}
+	if err := k.validateChannelUnusable(ctx, sourcePort, sourceChannel); err != nil {
+		return 0, err
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, destinationPort, destinationChannel)
+	if !found {
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", destinationPort, destinationChannel)
+	}
+	if channel.State != channeltypes.OPEN {
+		return 0, errorsmod.Wrapf(channeltypes.ErrInvalidChannelState,
+			"port ID (%s) channel ID (%s) is %s, expected %s", destinationPort, destinationChannel, channel.State, channeltypes.OPEN)
+	}
+
+	tokens := k.getEscrowedTokens(ctx, sourcePort, sourceChannel, classID)
+	for _, token := range tokens {
+		nft, found := k.nftKeeper.GetNFT(ctx, token.ClassId, token.TokenId)
+		if !found {
+			return 0, errorsmod.Wrapf(types.ErrInvalidTokenID, "class ID (%s) token ID (%s) not exist", token.ClassId, token.TokenId)
+		}
+		// the token is removed from the index of the source channel
+		if err := k.escrowToken(ctx, destinationPort, destinationChannel, token.ClassId, token.TokenId, nft.GetData()); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEscrowMigrated{
+		SourcePortId:         sourcePort,
+		SourceChannelId:      sourceChannel,
+		DestinationPortId:    destinationPort,
+		DestinationChannelId: destinationChannel,
+		ClassId:              classID,
+		TokenCount:           uint64(len(tokens)),
+	}); err != nil {
+		return 0, err
+	}
+	return uint64(len(tokens)), nil
+}
+
+// ReleaseEscrowedTokens releases tokens held by the escrow account of an
+// unusable channel to their owners and records the release together with its
+// evidence. The id of the record is returned.
+func (k Keeper) ReleaseEscrowedTokens(ctx sdk.Context, portID, channelID string, releases []types.EscrowRelease, evidence string) (uint64, error) {
+	if err := k.validateChannelUnusable(ctx, portID, channelID); err != nil {
+		return 0, err
+	}
+
+	// all the tokens are checked before any of them is released
+	receivers := make([]sdk.AccAddress, len(releases))
+	tokenData := make([]string, len(releases))
+	for i, release := range releases {
+		token, found := k.GetTokenEscrow(ctx, release.ClassId, release.TokenId)
+		if !found || token.PortId != portID || token.ChannelId != channelID {
+			return 0, errorsmod.Wrapf(types.ErrEscrowedTokenNotFound,
+				"port ID (%s) channel ID (%s) class ID (%s) token ID (%s)", portID, channelID, release.ClassId, release.TokenId)
+		}
+		nft, found := k.nftKeeper.GetNFT(ctx, release.ClassId, release.TokenId)
+		if !found {
+			return 0, errorsmod.Wrapf(types.ErrInvalidTokenID, "class ID (%s) token ID (%s) not exist", release.ClassId, release.TokenId)
+		}
+		tokenData[i] = nft.GetData()
+
+		receiver, err := sdk.AccAddressFromBech32(release.Receiver)
+		if err != nil {
+			return 0, err
+		}
+		receivers[i] = receiver
+	}
+
+	for i, release := range releases {
+		if err := k.unescrowToken(ctx, portID, channelID, release.ClassId, release.TokenId, tokenData[i], receivers[i]); err != nil {
+			return 0, err
+		}
+	}
+
+	id := k.getNextEscrowReleaseID(ctx)
+	k.setNextEscrowReleaseID(ctx, id+1)
+	k.SetEscrowReleaseRecord(ctx, types.EscrowReleaseRecord{
+		Id:        id,
+		PortId:    portID,
+		ChannelId: channelID,
+		Releases:  releases,
+		Evidence:  evidence,
+		Height:    ctx.BlockHeight(),
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEscrowReleased{
+		RecordId:  id,
+		PortId:    portID,
+		ChannelId: channelID,
+		Releases:  releases,
+	}); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// GetEscrowReleaseRecord returns the record of the tokens released from escrow
+// with the given id.
+func (k Keeper) GetEscrowReleaseRecord(ctx sdk.Context, id uint64) (types.EscrowReleaseRecord, bool) {
+	record, err := k.escrowReleaseRecords.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.EscrowReleaseRecord{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return record, true
+}
+
+// SetEscrowReleaseRecord stores the record of tokens released from escrow.
+func (k Keeper) SetEscrowReleaseRecord(ctx sdk.Context, record types.EscrowReleaseRecord) {
+	if err := k.escrowReleaseRecords.Set(ctx, record.Id, record); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllEscrowReleaseRecords returns all the records of the tokens released
+// from escrow.
+func (k Keeper) GetAllEscrowReleaseRecords(ctx sdk.Context) []types.EscrowReleaseRecord {
+	records := []types.EscrowReleaseRecord{}
+	err := k.escrowReleaseRecords.Walk(ctx, nil, func(_ uint64, record types.EscrowReleaseRecord) (bool, error) {
+		records = append(records, record)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return records
+}
+
+// getNextEscrowReleaseID returns the id of the next record of the tokens
+// released from escrow.
+func (k Keeper) getNextEscrowReleaseID(ctx sdk.Context) uint64 {
+	id, err := k.nextEscrowReleaseID.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 1
+	}
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// setNextEscrowReleaseID sets the id of the next record of the tokens released
+// from escrow.
+func (k Keeper) setNextEscrowReleaseID(ctx sdk.Context, id uint64) {
+	if err := k.nextEscrowReleaseID.Set(ctx, id); err != nil {
+		panic(err)
+	}
+}
+
+// getEscrowedTokens returns the tokens held by the escrow account of the given
+// port and channel, restricted to the class if not empty.
+func (k Keeper) getEscrowedTokens(ctx sdk.Context, portID, channelID, classID string) []types.EscrowedToken {
+	tokens := []types.EscrowedToken{}
+	ranger := new(collections.Range[escrowedTokenKey]).Prefix(escrowedTokensPrefix(portID, channelID, classID))
+	err := k.escrowedTokens.Walk(ctx, ranger, func(_ escrowedTokenKey, token types.EscrowedToken) (bool, error) {
+		tokens = append(tokens, token)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return tokens
+}
+
+// validateChannelUnusable checks that no packet can be relayed over the given
+// channel anymore: the channel is closed or its client is not active, e.g.
+// because it expired.
+func (k Keeper) validateChannelUnusable(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	if channel.State == channeltypes.CLOSED {
+		return nil
+	}
+
+	clientID, clientState, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status == exported.Active {
+		return errorsmod.Wrapf(types.ErrChannelUsable,
+			"port ID (%s) channel ID (%s) is %s and its client %s is %s", portID, channelID, channel.State, clientID, status)
+	}
+	return nil
+}
@@ -0,0 +1,368 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/bianjieai/nft-transfer/keeper"
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestMsgMigrateEscrow tests that the tokens escrowed by a channel whose client
+// expired or which was closed are moved to the escrow of another channel, and
+// that the escrow of a usable channel cannot be migrated.
+func (suite *KeeperTestSuite) TestMsgMigrateEscrow() {
+	var (
+		path, newPath *ibctesting.Path
+		classID       = "cryptoCat"
+		msg           *types.MsgMigrateEscrow
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expTokens []string
+		expErr    error
+	}{
+		{
+			"success: client expired",
+			func() { suite.expireClients(path) },
+			[]string{"kitty1", "kitty2"},
+			nil,
+		},
+		{
+			"success: channel closed",
+			func() { suite.Require().NoError(path.EndpointA.SetChannelState(channeltypes.CLOSED)) },
+			[]string{"kitty1", "kitty2"},
+			nil,
+		},
+		{
+			"success: tokens of another class kept",
+			func() {
+				suite.expireClients(path)
+				msg.ClassId = "otherCat"
+			},
+			nil,
+			nil,
+		},
+		{
+			"failure: channel still usable",
+			func() {},
+			nil,
+			types.ErrChannelUsable,
+		},
+		{
+			"failure: destination port not bound by nft-transfer",
+			func() {
+				suite.expireClients(path)
+				msg.DestinationPortId = "transfer"
+			},
+			nil,
+			porttypes.ErrInvalidPort,
+		},
+		{
+			"failure: same source and destination channel",
+			func() {
+				suite.expireClients(path)
+				msg.DestinationPortId = msg.SourcePortId
+				msg.DestinationChannelId = msg.SourceChannelId
+			},
+			nil,
+			sdkerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: destination channel not open",
+			func() {
+				suite.Require().NoError(newPath.EndpointA.SetChannelState(channeltypes.CLOSED))
+				suite.expireClients(path)
+			},
+			nil,
+			channeltypes.ErrInvalidChannelState,
+		},
+		{
+			"failure: invalid authority",
+			func() {
+				suite.expireClients(path)
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			nil,
+			govtypes.ErrInvalidSigner,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs(classID, "kitty1", "kitty2")
+			suite.escrowNFTs(path, classID, "kitty1", "kitty2")
+
+			newPath = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(newPath)
+
+			nftTransferKeeper := suite.GetSimApp(suite.chainA).NFTTransferKeeper
+			msg = types.NewMsgMigrateEscrow(
+				nftTransferKeeper.GetAuthority(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				newPath.EndpointA.ChannelConfig.PortID,
+				newPath.EndpointA.ChannelID,
+				classID,
+			)
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := nftTransferKeeper.MigrateEscrow(ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(len(tc.expTokens)), res.TokenCount)
+
+			newEscrowAddress := types.GetEscrowAddress(newPath.EndpointA.ChannelConfig.PortID, newPath.EndpointA.ChannelID)
+			for _, tokenID := range tc.expTokens {
+				suite.Require().Equal(newEscrowAddress, suite.GetSimApp(suite.chainA).NFTKeeper.GetOwner(ctx, classID, tokenID))
+				token, found := nftTransferKeeper.GetTokenEscrow(ctx, classID, tokenID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewEscrowedToken(
+					newPath.EndpointA.ChannelConfig.PortID, newPath.EndpointA.ChannelID, classID, tokenID,
+				), token)
+			}
+			suite.Require().Contains(suite.typedEvents(ctx.EventManager().ABCIEvents()), &types.EventEscrowMigrated{
+				SourcePortId:         path.EndpointA.ChannelConfig.PortID,
+				SourceChannelId:      path.EndpointA.ChannelID,
+				DestinationPortId:    newPath.EndpointA.ChannelConfig.PortID,
+				DestinationChannelId: newPath.EndpointA.ChannelID,
+				ClassId:              msg.ClassId,
+				TokenCount:           res.TokenCount,
+			})
+		})
+	}
+}
+
+// nftKeeperRecordingTransfers records the token data passed to Transfer.
+type nftKeeperRecordingTransfers struct {
+	types.NFTKeeper
+	tokenData map[string]string
+}
+
+func (k nftKeeperRecordingTransfers) Transfer(ctx sdk.Context, classID, tokenID, tokenData string, receiver sdk.AccAddress) error {
+	k.tokenData[tokenID] = tokenData
+	return k.NFTKeeper.Transfer(ctx, classID, tokenID, tokenData, receiver)
+}
+
+// TestMigrateEscrowedTokensTokenData tests that the tokens moved to the escrow
+// of another channel or released to their owners are transferred with their
+// data, which an NFTKeeper may otherwise overwrite.
+func (suite *KeeperTestSuite) TestMigrateEscrowedTokensTokenData() {
+	suite.SetupTest() // reset
+
+	classID := "cryptoCat"
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	suite.mintNFTs(classID, "kitty1", "kitty2")
+	suite.escrowNFTs(path, classID, "kitty1", "kitty2")
+	newPath := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(newPath)
+	suite.expireClients(path)
+
+	app := suite.GetSimApp(suite.chainA)
+	nftKeeper := nftKeeperRecordingTransfers{app.NFTTransferKeeper.GetNFTKeeper(), make(map[string]string)}
+	nftTransferKeeper := keeper.NewKeeper(
+		app.AppCodec(),
+		runtime.NewKVStoreService(app.GetKey(types.StoreKey)),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.IBCFeeKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
+		app.IBCKeeper.PortKeeper,
+		app.AccountKeeper,
+		nftKeeper,
+		app.ScopedNFTTransferKeeper,
+	)
+
+	ctx := suite.chainA.GetContext()
+	nft, found := nftKeeper.GetNFT(ctx, classID, "kitty1")
+	suite.Require().True(found)
+	suite.Require().NotEmpty(nft.GetData())
+
+	_, err := nftTransferKeeper.MigrateEscrowedTokens(ctx,
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		newPath.EndpointA.ChannelConfig.PortID, newPath.EndpointA.ChannelID, "",
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(map[string]string{"kitty1": nft.GetData(), "kitty2": nft.GetData()}, nftKeeper.tokenData)
+
+	suite.expireClients(newPath)
+	ctx = suite.chainA.GetContext()
+	_, err = nftTransferKeeper.ReleaseEscrowedTokens(ctx, newPath.EndpointA.ChannelConfig.PortID, newPath.EndpointA.ChannelID,
+		[]types.EscrowRelease{types.NewEscrowRelease(classID, "kitty1", suite.chainA.SenderAccount.GetAddress().String())},
+		"evidence",
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(nft.GetData(), nftKeeper.tokenData["kitty1"])
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), nftKeeper.GetOwner(ctx, classID, "kitty1"))
+}
+
+// TestMsgReleaseEscrow tests that the tokens escrowed by a channel whose client
+// expired are released to their owners with the evidence recorded, and that
+// the escrow of a usable channel cannot be released.
+func (suite *KeeperTestSuite) TestMsgReleaseEscrow() {
+	var (
+		path    *ibctesting.Path
+		classID = "cryptoCat"
+		owner   = sdk.AccAddress([]byte("owner_______________"))
+		msg     *types.MsgReleaseEscrow
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: client expired",
+			func() { suite.expireClients(path) },
+			nil,
+		},
+		{
+			"failure: channel still usable",
+			func() {},
+			types.ErrChannelUsable,
+		},
+		{
+			"failure: token not escrowed by the channel",
+			func() {
+				suite.expireClients(path)
+				msg.Releases = append(msg.Releases, types.NewEscrowRelease(classID, "kitty3", owner.String()))
+			},
+			types.ErrEscrowedTokenNotFound,
+		},
+		{
+			"failure: empty evidence",
+			func() {
+				suite.expireClients(path)
+				msg.Evidence = ""
+			},
+			types.ErrInvalidEscrowRelease,
+		},
+		{
+			"failure: invalid authority",
+			func() {
+				suite.expireClients(path)
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			govtypes.ErrInvalidSigner,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs(classID, "kitty1", "kitty2", "kitty3")
+			suite.escrowNFTs(path, classID, "kitty1", "kitty2")
+
+			nftTransferKeeper := suite.GetSimApp(suite.chainA).NFTTransferKeeper
+			msg = types.NewMsgReleaseEscrow(
+				nftTransferKeeper.GetAuthority(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				[]types.EscrowRelease{types.NewEscrowRelease(classID, "kitty1", owner.String())},
+				"ipfs://evidence",
+			)
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			res, err := nftTransferKeeper.ReleaseEscrow(ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(escrowAddress, suite.GetSimApp(suite.chainA).NFTKeeper.GetOwner(ctx, classID, "kitty1"))
+				suite.Require().Empty(nftTransferKeeper.GetAllEscrowReleaseRecords(ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), res.RecordId)
+
+			suite.Require().Equal(owner, suite.GetSimApp(suite.chainA).NFTKeeper.GetOwner(ctx, classID, "kitty1"))
+			suite.Require().Equal(escrowAddress, suite.GetSimApp(suite.chainA).NFTKeeper.GetOwner(ctx, classID, "kitty2"))
+			_, found := nftTransferKeeper.GetTokenEscrow(ctx, classID, "kitty1")
+			suite.Require().False(found)
+
+			record := types.EscrowReleaseRecord{
+				Id:        res.RecordId,
+				PortId:    msg.PortId,
+				ChannelId: msg.ChannelId,
+				Releases:  msg.Releases,
+				Evidence:  msg.Evidence,
+				Height:    ctx.BlockHeight(),
+			}
+			recordsRes, err := suite.queryClient.EscrowReleaseRecords(ctx, &types.QueryEscrowReleaseRecordsRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal([]types.EscrowReleaseRecord{record}, recordsRes.Records)
+			suite.Require().Contains(suite.typedEvents(ctx.EventManager().ABCIEvents()), &types.EventEscrowReleased{
+				RecordId:  res.RecordId,
+				PortId:    msg.PortId,
+				ChannelId: msg.ChannelId,
+				Releases:  msg.Releases,
+			})
+
+			// the ids of the records are not reused
+			msg.Releases = []types.EscrowRelease{types.NewEscrowRelease(classID, "kitty2", owner.String())}
+			res, err = nftTransferKeeper.ReleaseEscrow(ctx, msg)
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(2), res.RecordId)
+		})
+	}
+}
+
+// escrowNFTs sends the tokens of the class from chainA to chainB over the path
+// and relays the packet, leaving the tokens in the escrow of the channel.
+func (suite *KeeperTestSuite) escrowNFTs(path *ibctesting.Path, classID string, tokenIDs ...string) {
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		classID,
+		tokenIDs,
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(),
+		0,
+		"",
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+}
+
+// expireClients lets the clients of the path expire by committing a block on
+// both chains once the trusting period elapsed without updating them.
+func (suite *KeeperTestSuite) expireClients(path *ibctesting.Path) {
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+	suite.Require().Equal(exported.Expired, suite.GetSimApp(suite.chainA).IBCKeeper.ClientKeeper.GetClientStatus(
+		suite.chainA.GetContext(), path.EndpointA.GetClientState(), path.EndpointA.ClientID,
+	))
+}
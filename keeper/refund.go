@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// GetRefundRecord returns the refund record of the packet sent with the given
+// port, channel and sequence.
+func (k Keeper) GetRefundRecord(ctx sdk.Context, portID, channelID string, sequence uint64) (types.RefundRecord, bool) {
+	record, err := k.refundRecords.Get(ctx, collections.Join3(portID, channelID, sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RefundRecord{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return record, true
+}
+
+// SetRefundRecord stores the refund record under the port, channel and sequence
+// of its packet.
+func (k Keeper) SetRefundRecord(ctx sdk.Context, record types.RefundRecord) {
+	if err := k.refundRecords.Set(ctx, collections.Join3(record.PortId, record.ChannelId, record.Sequence), record); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteRefundRecord removes the refund record of the packet sent with the
+// given port, channel and sequence.
+func (k Keeper) DeleteRefundRecord(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	if err := k.refundRecords.Remove(ctx, collections.Join3(portID, channelID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllRefundRecords returns all the refund records.
+func (k Keeper) GetAllRefundRecords(ctx sdk.Context) []types.RefundRecord {
+	records := []types.RefundRecord{}
+	err := k.refundRecords.Walk(ctx, nil, func(_ collections.Triple[string, string, uint64], record types.RefundRecord) (bool, error) {
+		records = append(records, record)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return records
+}
+
+// getRefundAddress returns the address refunded the tokens of the packet sent:
+// the refund address recorded when the packet was sent, or else the sender.
+// The refund address is never read from the packet data, which is relayed
+// back by the counterparty.
+func (k Keeper) getRefundAddress(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) (sdk.AccAddress, error) {
+	if record, found := k.GetRefundRecord(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()); found {
+		return sdk.AccAddressFromBech32(record.RefundAddress)
+	}
+	return sdk.AccAddressFromBech32(data.Sender)
+}
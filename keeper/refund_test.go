@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestRefundAddress tests that the tokens of a failed transfer are refunded to
+// the refund address recorded on send, and that the record is removed once the
+// packet is resolved.
+func (suite *KeeperTestSuite) TestRefundAddress() {
+	var (
+		path          *ibctesting.Path
+		classID       = "cryptoCat"
+		refundAddress = sdk.AccAddress([]byte("refund-address______"))
+	)
+
+	testCases := []struct {
+		msg           string
+		refundAddress string
+		malleate      func(packet channeltypes.Packet)
+		expOwner      func() sdk.AccAddress
+	}{
+		{
+			"success: tokens received",
+			refundAddress.String(),
+			func(packet channeltypes.Packet) {
+				suite.Require().NoError(path.RelayPacket(packet))
+			},
+			func() sdk.AccAddress {
+				return types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			},
+		},
+		{
+			"success: error acknowledgement refunded to the refund address",
+			refundAddress.String(),
+			func(packet channeltypes.Packet) {
+				suite.Require().NoError(suite.GetSimApp(suite.chainB).NFTTransferKeeper.SetParams(
+					suite.chainB.GetContext(), types.NewParams(true, false),
+				))
+				suite.Require().NoError(path.RelayPacket(packet))
+			},
+			func() sdk.AccAddress { return refundAddress },
+		},
+		{
+			"success: timeout refunded to the refund address",
+			refundAddress.String(),
+			func(packet channeltypes.Packet) {
+				suite.coordinator.IncrementTimeBy(time.Minute)
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+			},
+			func() sdk.AccAddress { return refundAddress },
+		},
+		{
+			"success: timeout refunded to the sender without refund address",
+			"",
+			func(packet channeltypes.Packet) {
+				suite.coordinator.IncrementTimeBy(time.Minute)
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+			},
+			func() sdk.AccAddress { return suite.chainA.SenderAccount.GetAddress() },
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs(classID, "kitty1")
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				classID,
+				[]string{"kitty1"},
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.ZeroHeight(),
+				uint64(suite.chainB.GetContext().BlockTime().Add(time.Second*30).UnixNano()),
+				"",
+			)
+			msg.RefundAddress = tc.refundAddress
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			nftTransferKeeper := suite.GetSimApp(suite.chainA).NFTTransferKeeper
+			record, found := nftTransferKeeper.GetRefundRecord(
+				suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+			)
+			suite.Require().Equal(tc.refundAddress != "", found)
+			if found {
+				suite.Require().Equal(types.NewRefundRecord(
+					packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), tc.refundAddress,
+				), record)
+
+				recordRes, err := suite.queryClient.RefundRecord(suite.chainA.GetContext(), &types.QueryRefundRecordRequest{
+					PortId: packet.GetSourcePort(), ChannelId: packet.GetSourceChannel(), Sequence: packet.GetSequence(),
+				})
+				suite.Require().NoError(err)
+				suite.Require().Equal(record, recordRes.RefundRecord)
+
+				recordsRes, err := suite.queryClient.RefundRecords(suite.chainA.GetContext(), &types.QueryRefundRecordsRequest{})
+				suite.Require().NoError(err)
+				suite.Require().Equal([]types.RefundRecord{record}, recordsRes.RefundRecords)
+			}
+
+			tc.malleate(packet)
+
+			ctx := suite.chainA.GetContext()
+			suite.Require().Equal(tc.expOwner(), suite.GetSimApp(suite.chainA).NFTKeeper.GetOwner(ctx, classID, "kitty1"))
+			suite.Require().Empty(nftTransferKeeper.GetAllRefundRecords(ctx))
+
+			_, err = suite.queryClient.RefundRecord(ctx, &types.QueryRefundRecordRequest{
+				PortId: packet.GetSourcePort(), ChannelId: packet.GetSourceChannel(), Sequence: packet.GetSequence(),
+			})
+			suite.Require().Error(err)
+		})
+	}
+}
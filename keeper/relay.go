@@ -1,15 +1,18 @@
 package keeper
 
 import (
+	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
-	"github.com/hashicorp/go-metrics"
+	storetypes "cosmossdk.io/store/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -44,6 +47,11 @@ import (
 // |    A    | p6/c6/p4/c4/p2/c2/nftClass |    (p6,c6)     |    (p5,c5)     |       p4/c4/p2/c2/nftClass |    C    |
 // |    C    |       p4/c4/p2/c2/nftClass |    (p4,c4)     |    (p3,c3)     |             p2/c2/nftClass |    B    |
 // |    B    |             p2/c2/nftClass |    (p2,c2)     |    (p1,c1)     |                   nftClass |    A    |
+//
+// The tokens are split into several packets if they exceed the
+// MaxTokensPerPacket or MaxPacketBytes params, each of which is refunded
+// independently. The sequences of the packets are returned in the order they
+// were sent.
 func (k Keeper) SendTransfer(
 	ctx sdk.Context,
 	sourcePort,
@@ -55,26 +63,29 @@ func (k Keeper) SendTransfer(
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 	memo string,
-) (uint64, error) {
+) ([]uint64, error) {
 	if !k.GetSendEnabled(ctx) {
-		return 0, types.ErrSendDisabled
+		return nil, types.ErrSendDisabled
+	}
+
+	if !k.GetChannelParams(ctx, sourcePort, sourceChannel).SendEnabled {
+		return nil, errorsmod.Wrapf(types.ErrChannelSendDisabled, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
 	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
-		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
-	destinationPort := channel.GetCounterparty().GetPortID()
-	destinationChannel := channel.GetCounterparty().GetChannelID()
+	version := getAppVersion(channel.Version)
 
 	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
 	if !ok {
-		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+		return nil, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
 	// See spec for this logic: https://github.com/cosmos/ibc/blob/master/spec/app/ics-721-nft-transfer/README.md#packet-relay
-	packet, err := k.createOutgoingPacket(ctx,
+	packets, err := k.createOutgoingPackets(ctx,
 		sourcePort,
 		sourceChannel,
 		classID,
@@ -82,7 +93,39 @@ func (k Keeper) SendTransfer(
 		sender,
 		receiver,
 		memo,
+		version,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	sequences := make([]uint64, len(packets))
+	for i, data := range packets {
+		sequence, err := k.sendPacket(ctx, channel, channelCap, sourcePort, sourceChannel, classID, data, sender, timeoutHeight, timeoutTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		sequences[i] = sequence
+	}
+	return sequences, nil
+}
+
+// sendPacket sends a single packet of the tokens of the class, which have
+// already been escrowed or burnt, and returns its sequence.
+func (k Keeper) sendPacket(
+	ctx sdk.Context,
+	channel channeltypes.Channel,
+	channelCap *capabilitytypes.Capability,
+	sourcePort,
+	sourceChannel,
+	classID string,
+	packet types.NonFungibleTokenPacketData,
+	sender sdk.AccAddress,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	tokenCount := uint64(len(packet.TokenIds))
+	rateLimitFlow, rateLimited, err := k.checkSendRateLimit(ctx, sourcePort, sourceChannel, classID, tokenCount)
 	if err != nil {
 		return 0, err
 	}
@@ -92,16 +135,24 @@ func (k Keeper) SendTransfer(
 		return 0, err
 	}
 
-	defer func() {
-		labels := []metrics.Label{
-			telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
-			telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
-		}
+	if rateLimited {
+		// remember the quota taken by the packet so that it can be released on refund
+		k.SetPendingSendPacket(ctx, types.NewPendingSendPacket(
+			sourcePort, sourceChannel, sequence, classID, tokenCount, rateLimitFlow.WindowStart,
+		))
+	}
 
-		telemetry.SetGaugeWithLabels(
-			[]string{"tx", "msg", "ibc", "nft-transfer"},
-			float32(len(tokenIDs)),
-			[]metrics.Label{telemetry.NewLabel("class_id", classID)},
+	// remember the send height so that the latency of the packet can be reported
+	k.SetPacketSendHeight(ctx, types.NewPacketSendHeight(sourcePort, sourceChannel, sequence, ctx.BlockHeight()))
+
+	if err := k.emitTransferSentEvents(ctx, sourcePort, sourceChannel, sequence, classID, packet.TokenIds, sender, packet.Receiver, packet.Memo); err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		labels := append(
+			channelLabels(sourcePort, sourceChannel, channel.GetCounterparty().GetPortID(), channel.GetCounterparty().GetChannelID()),
+			telemetry.NewLabel(coretypes.LabelSource, strconv.FormatBool(types.IsAwayFromOrigin(sourcePort, sourceChannel, packet.ClassId))),
 		)
 
 		telemetry.IncrCounterWithLabels(
@@ -109,77 +160,249 @@ func (k Keeper) SendTransfer(
 			1,
 			labels,
 		)
+
+		addSampleWithLabels(
+			[]string{"ibc", types.ModuleName, "send", "tokens_per_packet"},
+			float32(tokenCount),
+			labels,
+		)
 	}()
 	return sequence, nil
 }
 
+// SendBatchTransfer handles the sending of the tokens of several classes to the
+// same receiver, with the packets of each class sent in the order given. The
+// ownership of all the tokens is checked before any packet is sent, and either
+// all the packets are sent or none. The sequences of the packets are returned
+// in the order they were sent.
+func (k Keeper) SendBatchTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	classTokens []types.ClassTokens,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) ([]uint64, error) {
+	for _, tokens := range classTokens {
+		if err := k.validateTokenOwnership(ctx, tokens.ClassId, tokens.TokenIds, sender); err != nil {
+			return nil, err
+		}
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	var sequences []uint64
+	for _, tokens := range classTokens {
+		classSequences, err := k.SendTransfer(
+			cacheCtx, sourcePort, sourceChannel, tokens.ClassId, tokens.TokenIds,
+			sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+		)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "class ID (%s)", tokens.ClassId)
+		}
+		sequences = append(sequences, classSequences...)
+	}
+	writeCache()
+
+	return sequences, nil
+}
+
+// validateTokenOwnership checks that the tokens of the class exist and are owned
+// by the sender.
+func (k Keeper) validateTokenOwnership(ctx sdk.Context, classID string, tokenIDs []string, sender sdk.AccAddress) error {
+	if !k.nftKeeper.HasClass(ctx, classID) {
+		return errorsmod.Wrapf(types.ErrInvalidClassID, "classId %s not exist", classID)
+	}
+
+	for _, tokenID := range tokenIDs {
+		if _, exist := k.nftKeeper.GetNFT(ctx, classID, tokenID); !exist {
+			return errorsmod.Wrapf(types.ErrInvalidTokenID, "tokenId %s of class %s not exist", tokenID, classID)
+		}
+		if !sender.Equals(k.nftKeeper.GetOwner(ctx, classID, tokenID)) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "not owner of token %s of class %s", tokenID, classID)
+		}
+	}
+	return nil
+}
+
 // OnRecvPacket processes a cross chain fungible token transfer. If the
 // sender chain is the source of minted tokens then vouchers will be minted
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
-// unescrowed and sent to the receiving address.
+// unescrowed and sent to the receiving address. The result of the success
+// acknowledgement is returned.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet,
-	data types.NonFungibleTokenPacketData) error {
+	data types.NonFungibleTokenPacketData) (types.NonFungibleTokenPacketResult, error) {
+	if err := k.validateCircuitAllowed(ctx, types.RecvPacketTypeURL); err != nil {
+		return types.NonFungibleTokenPacketResult{}, err
+	}
+
 	if !k.GetReceiveEnabled(ctx) {
-		return types.ErrReceiveDisabled
+		return types.NonFungibleTokenPacketResult{}, types.ErrReceiveDisabled
+	}
+
+	if !k.GetChannelParams(ctx, packet.GetDestPort(), packet.GetDestChannel()).ReceiveEnabled {
+		return types.NonFungibleTokenPacketResult{}, errorsmod.Wrapf(types.ErrChannelReceiveDisabled, "port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
 	}
 
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
-		return err
+		return types.NonFungibleTokenPacketResult{}, err
+	}
+
+	if err := k.validatePacketVersion(ctx, packet, data); err != nil {
+		return types.NonFungibleTokenPacketResult{}, err
+	}
+
+	if err := k.validateReceiveClass(ctx, packet, data); err != nil {
+		return types.NonFungibleTokenPacketResult{}, err
+	}
+
+	if err := k.checkReceiveRateLimit(ctx, packet, data); err != nil {
+		return types.NonFungibleTokenPacketResult{}, err
 	}
 
 	// See spec for this logic: https://github.com/cosmos/ibc/blob/master/spec/app/ics-721-nft-transfer/README.md#packet-relay
-	return k.processReceivedPacket(ctx, packet, data)
+	result, err := k.processReceivedPacket(ctx, packet, data)
+	if err != nil {
+		return result, err
+	}
+
+	defer func() {
+		// this chain is the source of the tokens returned to it
+		labels := packetLabels(packet, !types.IsAwayFromOrigin(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId))
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", types.ModuleName, "receive"},
+			1,
+			labels,
+		)
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", types.ModuleName, "receive", "tokens"},
+			float32(len(data.TokenIds)),
+			labels,
+		)
+
+		addSampleWithLabels(
+			[]string{"ibc", types.ModuleName, "receive", "tokens_per_packet"},
+			float32(len(data.TokenIds)),
+			labels,
+		)
+	}()
+	return result, nil
 }
 
 // OnAcknowledgementPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain. If the acknowledgement
-// was a success then nothing occurs. If the acknowledgement failed, then
-// the sender is refunded their tokens using the refundPacketToken function.
+// was a success then only the AfterAcknowledgementSuccess hook is called. If
+// the acknowledgement failed, then the sender is refunded their tokens using
+// the refundPacketToken function.
+// The rate limit quota taken by a refunded packet is released, and the refund
+// record of the packet is removed. The latency of the packet is reported.
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	labels := packetLabels(packet, types.IsAwayFromOrigin(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId))
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if err := k.refundPacketToken(ctx, packet, data); err != nil {
+			return err
+		}
+		k.releaseSendRateLimit(ctx, packet, true)
+		k.DeleteRefundRecord(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		k.reportPacketResolved(ctx, packet,
+			[]string{"ibc", types.ModuleName, "acknowledgement"},
+			append(labels, telemetry.NewLabel(labelSuccess, "false")),
+		)
+		return nil
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing
-		// needs to be executed and no error needs to be returned
+		// needs to be executed but the hooks
+		k.releaseSendRateLimit(ctx, packet, false)
+		k.DeleteRefundRecord(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		k.reportPacketResolved(ctx, packet,
+			[]string{"ibc", types.ModuleName, "acknowledgement"},
+			append(labels, telemetry.NewLabel(labelSuccess, "true")),
+		)
+
+		info, err := k.getSentTransferInfo(ctx, packet, data)
+		if err != nil {
+			return err
+		}
+		k.callResolvedPacketHook(ctx, packet, types.HookAfterAcknowledgementSuccess, func(ctx sdk.Context) error {
+			return k.Hooks().AfterAcknowledgementSuccess(ctx, info)
+		})
 		return nil
 	}
 }
 
 // OnTimeoutPacket refunds the sender since the original packet sent was
-// never received and has been timed out.
+// never received and has been timed out. The latency of the packet is reported.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	if err := k.refundPacketToken(ctx, packet, data); err != nil {
+		return err
+	}
+	k.releaseSendRateLimit(ctx, packet, true)
+	k.DeleteRefundRecord(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.reportPacketResolved(ctx, packet,
+		[]string{"ibc", types.ModuleName, "timeout"},
+		packetLabels(packet, types.IsAwayFromOrigin(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId)),
+	)
+	return nil
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
-// the sending address.
+// the sending address. The tokens are sent to the refund address recorded
+// for the packet instead, if any. The AfterRefund hook is called once refunded.
 func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
-	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	sender, err := k.getRefundAddress(ctx, packet, data)
 	if err != nil {
 		return err
 	}
 
-	voucherClassID, err := k.GetVoucherClassID(ctx, data.ClassId)
+	info, err := k.getSentTransferInfo(ctx, packet, data)
 	if err != nil {
 		return err
 	}
 	if types.IsAwayFromOrigin(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
 		for i, tokenID := range data.TokenIds {
-			if err := k.nftKeeper.Transfer(ctx, voucherClassID, tokenID, types.GetIfExist(i, data.TokenData), sender); err != nil {
+			if err := k.unescrowToken(ctx,
+				packet.GetSourcePort(), packet.GetSourceChannel(),
+				info.VoucherClassID, tokenID, types.GetIfExist(i, data.TokenData), sender); err != nil {
 				return err
 			}
 		}
+		if err := ctx.EventManager().EmitTypedEvents(
+			&types.EventUnescrowed{
+				PortId:         packet.GetSourcePort(),
+				ChannelId:      packet.GetSourceChannel(),
+				Sequence:       packet.GetSequence(),
+				VoucherClassId: info.VoucherClassID,
+				TokenIds:       data.TokenIds,
+				EscrowAddress:  types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel()).String(),
+				Receiver:       sender.String(),
+			},
+			newRefundedEvent(packet, info, sender, types.DirectionEscrow),
+		); err != nil {
+			return err
+		}
+
+		labels := packetLabels(packet, true)
+		telemetry.IncrCounterWithLabels([]string{"ibc", types.ModuleName, "tokens", "unescrowed"}, float32(len(data.TokenIds)), labels)
+		telemetry.IncrCounterWithLabels([]string{"ibc", types.ModuleName, "refund"}, 1, labels)
+		telemetry.IncrCounterWithLabels([]string{"ibc", types.ModuleName, "refund", "tokens"}, float32(len(data.TokenIds)), labels)
+		k.callResolvedPacketHook(ctx, packet, types.HookAfterRefund, func(ctx sdk.Context) error {
+			return k.Hooks().AfterRefund(ctx, info)
+		})
 		return nil
 	}
 
 	for i, tokenID := range data.TokenIds {
 		if err := k.nftKeeper.Mint(ctx,
-			voucherClassID,
+			info.VoucherClassID,
 			tokenID,
 			types.GetIfExist(i, data.TokenUris),
 			types.GetIfExist(i, data.TokenData),
@@ -188,14 +411,93 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 			return err
 		}
 	}
+	if err := ctx.EventManager().EmitTypedEvents(
+		&types.EventVoucherMinted{
+			PortId:         packet.GetSourcePort(),
+			ChannelId:      packet.GetSourceChannel(),
+			Sequence:       packet.GetSequence(),
+			ClassTrace:     info.ClassTrace,
+			VoucherClassId: info.VoucherClassID,
+			TokenIds:       data.TokenIds,
+			Owner:          sender.String(),
+		},
+		newRefundedEvent(packet, info, sender, types.DirectionBurn),
+	); err != nil {
+		return err
+	}
+
+	labels := packetLabels(packet, false)
+	telemetry.IncrCounterWithLabels([]string{"ibc", types.ModuleName, "vouchers", "minted"}, float32(len(data.TokenIds)), labels)
+	telemetry.IncrCounterWithLabels([]string{"ibc", types.ModuleName, "refund"}, 1, labels)
+	telemetry.IncrCounterWithLabels([]string{"ibc", types.ModuleName, "refund", "tokens"}, float32(len(data.TokenIds)), labels)
+	k.callResolvedPacketHook(ctx, packet, types.HookAfterRefund, func(ctx sdk.Context) error {
+		return k.Hooks().AfterRefund(ctx, info)
+	})
 	return nil
 }
 
-// createOutgoingPacket will escrow the tokens to escrow account
+// callResolvedPacketHook calls a hook once the given packet is acknowledged or
+// refunded. The hook runs in a cached context whose state changes are written
+// only if it succeeds. A failed hook does not fail the acknowledgement or
+// timeout of the packet, which could then never be resolved: the error is
+// logged and reported by an event instead.
+func (k Keeper) callResolvedPacketHook(ctx sdk.Context, packet channeltypes.Packet, hook string, call func(ctx sdk.Context) error) {
+	gasLimit := min(ctx.GasMeter().GasRemaining(), types.ResolvedPacketHookGasLimit)
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	err := callHook(cacheCtx, call)
+	ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "nft-transfer hook")
+	if err != nil {
+		k.Logger(ctx).Error("nft-transfer hook failed",
+			"hook", hook,
+			"port", packet.GetSourcePort(),
+			"channel", packet.GetSourceChannel(),
+			"sequence", packet.GetSequence(),
+			"error", err.Error(),
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeHookFailed,
+				sdk.NewAttribute(types.AttributeKeyHook, hook),
+				sdk.NewAttribute(types.AttributeKeyPortID, packet.GetSourcePort()),
+				sdk.NewAttribute(types.AttributeKeyChannelID, packet.GetSourceChannel()),
+				sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+				sdk.NewAttribute(types.AttributeKeyHookError, err.Error()),
+			),
+		)
+		return
+	}
+	writeCache()
+}
+
+// callHook calls the hook, turning a panic, e.g. when the hook runs out of gas,
+// into an error.
+func callHook(ctx sdk.Context, call func(ctx sdk.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s", outOfGas.Descriptor)
+				return
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r)
+		}
+	}()
+	return call(ctx)
+}
+
+// createOutgoingPackets will escrow the tokens to escrow account
 // if the token was away from origin chain . Otherwise, the sent tokens
 // were burnt in the sending chain and will unescrow the token to receiver
-// in the destination chain
-func (k Keeper) createOutgoingPacket(ctx sdk.Context,
+// in the destination chain. The class trace is carried separately if the
+// channel has the ics721-2 version. The BeforeSendTransfer hook is called
+// before the tokens are escrowed or burnt.
+//
+// The packet data is split into several packets as set by the params before
+// the tokens are escrowed or burnt. A memo carrying forward or callback
+// instructions is rejected if the tokens are split, as the instructions would
+// be executed once per packet.
+func (k Keeper) createOutgoingPackets(ctx sdk.Context,
 	sourcePort,
 	sourceChannel,
 	classID string,
@@ -203,56 +505,60 @@ func (k Keeper) createOutgoingPacket(ctx sdk.Context,
 	sender sdk.AccAddress,
 	receiver string,
 	memo string,
-) (types.NonFungibleTokenPacketData, error) {
+	version string,
+) ([]types.NonFungibleTokenPacketData, error) {
 	class, exist := k.nftKeeper.GetClass(ctx, classID)
 	if !exist {
-		return types.NonFungibleTokenPacketData{}, errorsmod.Wrap(types.ErrInvalidClassID, "classId not exist")
+		return nil, errorsmod.Wrap(types.ErrInvalidClassID, "classId not exist")
 	}
 
 	var (
 		// NOTE: class and hex hash correctness checked during msg.ValidateBasic
-		fullClassPath = classID
-		err           error
-		tokenURIs     = make([]string, len(tokenIDs))
-		tokenData     = make([]string, len(tokenIDs))
+		tokenURIs = make([]string, len(tokenIDs))
+		tokenData = make([]string, len(tokenIDs))
 	)
 
 	// deconstruct the token denomination into the denomination trace info
 	// to determine if the sender is the source chain
-	if strings.HasPrefix(classID, "ibc/") {
-		fullClassPath, err = k.ClassPathFromHash(ctx, classID)
-		if err != nil {
-			return types.NonFungibleTokenPacketData{}, err
-		}
+	classTrace, err := k.getClassTrace(ctx, classID)
+	if err != nil {
+		return nil, err
+	}
+	fullClassPath := classTrace.GetFullClassPath()
+
+	if err := k.GetParams(ctx).ValidateSendClass(classID, fullClassPath); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateNotPaused(ctx, sourcePort, sourceChannel, classID, fullClassPath); err != nil {
+		return nil, err
+	}
+
+	if err := k.Hooks().BeforeSendTransfer(ctx, types.TransferInfo{
+		PortID:         sourcePort,
+		ChannelID:      sourceChannel,
+		ClassTrace:     classTrace,
+		VoucherClassID: classID,
+		TokenIDs:       tokenIDs,
+		Sender:         sender.String(),
+		Receiver:       receiver,
+	}); err != nil {
+		return nil, err
 	}
 
-	isAwayFromOrigin := types.IsAwayFromOrigin(sourcePort,
-		sourceChannel, fullClassPath)
 	for i, tokenID := range tokenIDs {
 		nft, exist := k.nftKeeper.GetNFT(ctx, classID, tokenID)
 		if !exist {
-			return types.NonFungibleTokenPacketData{}, errorsmod.Wrap(types.ErrInvalidTokenID, "tokenId not exist")
+			return nil, errorsmod.Wrap(types.ErrInvalidTokenID, "tokenId not exist")
 		}
 
 		owner := k.nftKeeper.GetOwner(ctx, classID, tokenID)
 		if !sender.Equals(owner) {
-			return types.NonFungibleTokenPacketData{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not token owner")
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not token owner")
 		}
 
 		tokenURIs[i] = nft.GetURI()
 		tokenData[i] = nft.GetData()
-
-		if isAwayFromOrigin {
-			// create the escrow address for the tokens
-			escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
-			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, nft.GetData(), escrowAddress); err != nil {
-				return types.NonFungibleTokenPacketData{}, err
-			}
-		} else {
-			if err := k.nftKeeper.Burn(ctx, classID, tokenID); err != nil {
-				return types.NonFungibleTokenPacketData{}, err
-			}
-		}
 	}
 
 	packetData := types.NewNonFungibleTokenPacketData(
@@ -266,36 +572,70 @@ func (k Keeper) createOutgoingPacket(ctx sdk.Context,
 		tokenData,
 		memo,
 	)
-	return packetData, packetData.ValidateBasic()
+	if version == types.V2 {
+		packetData = packetData.WithClassTrace(classTrace)
+	}
+	if err := packetData.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	packets, err := packetData.Split(params.MaxTokensPerPacket, params.MaxPacketBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(packets) > 1 && packetData.HasMemoInstructions() {
+		return nil, errorsmod.Wrapf(types.ErrPacketTooLarge,
+			"the tokens are split into %d packets, while the forward or callback instructions of the memo require a single packet", len(packets))
+	}
+
+	isAwayFromOrigin := types.IsAwayFromOrigin(sourcePort,
+		sourceChannel, fullClassPath)
+	for i, tokenID := range tokenIDs {
+		if isAwayFromOrigin {
+			// escrow the tokens in the escrow address of the channel
+			if err := k.escrowToken(ctx, sourcePort, sourceChannel, classID, tokenID, tokenData[i]); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := k.nftKeeper.Burn(ctx, classID, tokenID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return packets, nil
 }
 
 // processReceivedPacket will mint the tokens to receiver account
 // if the token was away from origin chain . Otherwise, the sent tokens
 // were burnt in the sending chain and will unescrow the token to receiver
-// in the destination chain
+// in the destination chain. The AfterReceive hook is called once received.
+// The result returned carries the class of the tokens on this chain.
 func (k Keeper) processReceivedPacket(ctx sdk.Context, packet channeltypes.Packet,
-	data types.NonFungibleTokenPacketData) error {
+	data types.NonFungibleTokenPacketData) (types.NonFungibleTokenPacketResult, error) {
+	var result types.NonFungibleTokenPacketResult
 	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
 	if err != nil {
-		return err
+		return result, err
 	}
 
 	if types.IsAwayFromOrigin(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
-		// since SendPacket did not prefix the classID, we must prefix classID here
-		classPrefix := types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel())
-		// NOTE: sourcePrefix contains the trailing "/"
-		prefixedClassID := classPrefix + data.ClassId
-
-		// construct the class trace from the full raw classID
-		classTrace := types.ParseClassTrace(prefixedClassID)
+		classTrace := k.getReceivedClassTrace(ctx, packet, data)
+		voucherClassID := classTrace.IBCClassID()
 		if !k.HasClassTrace(ctx, classTrace.Hash()) {
 			k.SetClassTrace(ctx, classTrace)
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventClassTraceCreated{
+				ClassTrace:     classTrace,
+				TraceHash:      classTrace.Hash().String(),
+				VoucherClassId: voucherClassID,
+			}); err != nil {
+				return result, err
+			}
 		}
 
-		voucherClassID := classTrace.IBCClassID()
 		if err := k.nftKeeper.CreateOrUpdateClass(ctx,
 			voucherClassID, data.ClassUri, data.ClassData); err != nil {
-			return err
+			return result, err
 		}
 
 		ctx.EventManager().EmitEvent(
@@ -313,10 +653,40 @@ func (k Keeper) processReceivedPacket(ctx sdk.Context, packet channeltypes.Packe
 				types.GetIfExist(i, data.TokenData),
 				receiver,
 			); err != nil {
-				return err
+				return result, err
 			}
 		}
-		return nil
+		if err := ctx.EventManager().EmitTypedEvents(
+			&types.EventVoucherMinted{
+				PortId:         packet.GetDestPort(),
+				ChannelId:      packet.GetDestChannel(),
+				Sequence:       packet.GetSequence(),
+				ClassTrace:     classTrace,
+				VoucherClassId: voucherClassID,
+				TokenIds:       data.TokenIds,
+				Owner:          data.Receiver,
+			},
+			newPacketReceivedEvent(packet, data, classTrace, voucherClassID, types.DirectionEscrow),
+		); err != nil {
+			return result, err
+		}
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", types.ModuleName, "vouchers", "minted"},
+			float32(len(data.TokenIds)),
+			packetLabels(packet, false),
+		)
+		if err := k.Hooks().AfterReceive(ctx, types.TransferInfo{
+			PortID:         packet.GetDestPort(),
+			ChannelID:      packet.GetDestChannel(),
+			ClassTrace:     classTrace,
+			VoucherClassID: voucherClassID,
+			TokenIDs:       data.TokenIds,
+			Sender:         data.Sender,
+			Receiver:       data.Receiver,
+		}); err != nil {
+			return result, err
+		}
+		return types.NewNonFungibleTokenPacketResult(voucherClassID, classTrace.Hash().String(), data.Receiver), nil
 	}
 
 	// If the token moves in the direction of back to origin,
@@ -324,15 +694,9 @@ func (k Keeper) processReceivedPacket(ctx sdk.Context, packet channeltypes.Packe
 
 	// we should remove the prefix. For example:
 	// p6/c6/p4/c4/p2/c2/nftClass -> p4/c4/p2/c2/nftClass
-	unprefixedClassID, err := types.RemoveClassPrefix(packet.GetSourcePort(),
-		packet.GetSourceChannel(), data.ClassId)
+	voucherClassID, err := k.getReturnedVoucherClassID(ctx, packet, data)
 	if err != nil {
-		return err
-	}
-
-	voucherClassID, err := k.GetVoucherClassID(ctx, unprefixedClassID)
-	if err != nil {
-		return err
+		return result, err
 	}
 
 	escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
@@ -341,17 +705,210 @@ func (k Keeper) processReceivedPacket(ctx sdk.Context, packet channeltypes.Packe
 		//FIX https://github.com/game-of-nfts/gon-evidence/issues/346
 		owner := k.nftKeeper.GetOwner(ctx, voucherClassID, tokenID)
 		if !escrowAddress.Equals(owner) {
-			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not token owner")
+			return result, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not token owner")
 		}
 
-		if err := k.nftKeeper.Transfer(ctx,
+		if err := k.unescrowToken(ctx,
+			packet.GetDestPort(), packet.GetDestChannel(),
 			voucherClassID, tokenID, types.GetIfExist(i, data.TokenData), receiver); err != nil {
+			return result, err
+		}
+	}
+
+	classTrace, err := k.getClassTrace(ctx, voucherClassID)
+	if err != nil {
+		return result, err
+	}
+	if err := ctx.EventManager().EmitTypedEvents(
+		&types.EventUnescrowed{
+			PortId:         packet.GetDestPort(),
+			ChannelId:      packet.GetDestChannel(),
+			Sequence:       packet.GetSequence(),
+			VoucherClassId: voucherClassID,
+			TokenIds:       data.TokenIds,
+			EscrowAddress:  escrowAddress.String(),
+			Receiver:       data.Receiver,
+		},
+		newPacketReceivedEvent(packet, data, classTrace, voucherClassID, types.DirectionBurn),
+	); err != nil {
+		return result, err
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{"ibc", types.ModuleName, "tokens", "unescrowed"},
+		float32(len(data.TokenIds)),
+		packetLabels(packet, true),
+	)
+	if err := k.Hooks().AfterReceive(ctx, types.TransferInfo{
+		PortID:         packet.GetDestPort(),
+		ChannelID:      packet.GetDestChannel(),
+		ClassTrace:     classTrace,
+		VoucherClassID: voucherClassID,
+		TokenIDs:       data.TokenIds,
+		Sender:         data.Sender,
+		Receiver:       data.Receiver,
+	}); err != nil {
+		return result, err
+	}
+
+	// the tokens returned to their chain of origin are not vouchers
+	var classTraceHash string
+	if classTrace.Path != "" {
+		classTraceHash = classTrace.Hash().String()
+	}
+	return types.NewNonFungibleTokenPacketResult(voucherClassID, classTraceHash, data.Receiver), nil
+}
+
+// validateReceiveClass checks that the class of the tokens in the given packet
+// may be received by this chain and that its transfers are not paused over the
+// destination channel.
+func (k Keeper) validateReceiveClass(ctx sdk.Context, packet channeltypes.Packet,
+	data types.NonFungibleTokenPacketData) error {
+	fullClassPath := types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.ClassId
+	if !types.IsAwayFromOrigin(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		unprefixedClassID, err := types.RemoveClassPrefix(packet.GetSourcePort(),
+			packet.GetSourceChannel(), data.ClassId)
+		if err != nil {
 			return err
 		}
+		fullClassPath = unprefixedClassID
+	}
+
+	classID, err := k.GetReceivedClassID(ctx, packet, data)
+	if err != nil {
+		return err
+	}
+	if err := k.GetParams(ctx).ValidateReceiveClass(classID, fullClassPath); err != nil {
+		return err
+	}
+	return k.validateNotPaused(ctx, packet.GetDestPort(), packet.GetDestChannel(), classID, fullClassPath)
+}
+
+// GetReceivedClassID returns the classID of the tokens held by this chain after
+// the given packet has been received.
+func (k Keeper) GetReceivedClassID(ctx sdk.Context, packet channeltypes.Packet,
+	data types.NonFungibleTokenPacketData) (string, error) {
+	if types.IsAwayFromOrigin(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		return k.getReceivedClassTrace(ctx, packet, data).IBCClassID(), nil
+	}
+	return k.getReturnedVoucherClassID(ctx, packet, data)
+}
+
+// validatePacketVersion checks that the given packet data carries the class trace
+// separately if and only if it is received over an ics721-2 channel.
+func (k Keeper) validatePacketVersion(ctx sdk.Context, packet channeltypes.Packet,
+	data types.NonFungibleTokenPacketData) error {
+	var version string
+	if channel, found := k.channelKeeper.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel()); found {
+		version = getAppVersion(channel.Version)
+	}
+	if (version == types.V2) != data.HasClassTrace() {
+		return errorsmod.Wrapf(types.ErrInvalidPacket,
+			"class trace must be carried separately if and only if the channel version is %s, got version %s", types.V2, version)
 	}
 	return nil
 }
 
+// getAppVersion returns the nft-transfer version of a channel with the given
+// version. The version of a channel incentivized by the ICS-29 fee middleware
+// wraps the nft-transfer version in the fee metadata, which is unwrapped here
+// instead of relying on the ICS4Wrapper to strip it. The version of channels
+// wrapped by any other middleware is not supported.
+func getAppVersion(channelVersion string) string {
+	metadata, err := ibcfeetypes.MetadataFromVersion(channelVersion)
+	if err != nil || metadata.FeeVersion != ibcfeetypes.Version {
+		return channelVersion
+	}
+	return metadata.AppVersion
+}
+
+// getReceivedClassTrace returns the class trace of the tokens received with the
+// given packet when they move away from the origin chain.
+//
+// The class trace carried by an ics721-2 packet may differ from the one parsed
+// from the full class path of an ics721-1 packet when the base classID contains
+// "/". The vouchers minted before the channel was upgraded are kept, so the
+// trace parsed from the full class path is used if its voucher class exists.
+func (k Keeper) getReceivedClassTrace(ctx sdk.Context, packet channeltypes.Packet,
+	data types.NonFungibleTokenPacketData) types.ClassTrace {
+	// since SendPacket did not prefix the classID, we must prefix classID here
+	// NOTE: sourcePrefix contains the trailing "/"
+	prefixedClassID := types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.ClassId
+
+	// construct the class trace from the full raw classID
+	parsedClassTrace := types.ParseClassTrace(prefixedClassID)
+	if !data.HasClassTrace() {
+		return parsedClassTrace
+	}
+
+	classTrace := data.GetClassTrace().AddPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	if classTrace != parsedClassTrace && !k.HasClassTrace(ctx, classTrace.Hash()) &&
+		k.HasClassTrace(ctx, parsedClassTrace.Hash()) {
+		return parsedClassTrace
+	}
+	return classTrace
+}
+
+// getReturnedVoucherClassID returns the classID of the tokens received with the
+// given packet when they move back toward the origin chain.
+//
+// The class trace carried by an ics721-2 packet is the one the sending chain
+// stored for its vouchers, which may have been parsed from the full class path
+// by ics721-1. Unless this chain holds a voucher class of this exact trace, the
+// classID is resolved from the full class path as for an ics721-1 packet.
+func (k Keeper) getReturnedVoucherClassID(ctx sdk.Context, packet channeltypes.Packet,
+	data types.NonFungibleTokenPacketData) (string, error) {
+	if data.HasClassTrace() {
+		classTrace, err := data.GetClassTrace().RemovePrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		if err != nil {
+			return "", err
+		}
+		if classTrace.Path == "" || k.HasClassTrace(ctx, classTrace.Hash()) {
+			return classTrace.IBCClassID(), nil
+		}
+		return k.GetVoucherClassID(ctx, classTrace.GetFullClassPath())
+	}
+
+	unprefixedClassID, err := types.RemoveClassPrefix(packet.GetSourcePort(),
+		packet.GetSourceChannel(), data.ClassId)
+	if err != nil {
+		return "", err
+	}
+	return k.GetVoucherClassID(ctx, unprefixedClassID)
+}
+
+// getSentTransferInfo returns the transfer info passed to the hooks for the
+// tokens sent with the given packet.
+func (k Keeper) getSentTransferInfo(ctx sdk.Context, packet channeltypes.Packet,
+	data types.NonFungibleTokenPacketData) (types.TransferInfo, error) {
+	voucherClassID, err := k.getSentVoucherClassID(ctx, data)
+	if err != nil {
+		return types.TransferInfo{}, err
+	}
+
+	classTrace, err := k.getClassTrace(ctx, voucherClassID)
+	if err != nil {
+		return types.TransferInfo{}, err
+	}
+	return types.TransferInfo{
+		PortID:         packet.GetSourcePort(),
+		ChannelID:      packet.GetSourceChannel(),
+		ClassTrace:     classTrace,
+		VoucherClassID: voucherClassID,
+		TokenIDs:       data.TokenIds,
+		Sender:         data.Sender,
+		Receiver:       data.Receiver,
+	}, nil
+}
+
+// getSentVoucherClassID returns the classID of the tokens sent with the given
+// packet data on the sending chain.
+func (k Keeper) getSentVoucherClassID(ctx sdk.Context, data types.NonFungibleTokenPacketData) (string, error) {
+	if data.HasClassTrace() {
+		return data.GetClassTrace().IBCClassID(), nil
+	}
+	return k.GetVoucherClassID(ctx, data.ClassId)
+}
+
 func (k Keeper) GetVoucherClassID(ctx sdk.Context, classID string) (string, error) {
 
 	// If "/" is not included after removing the prefix,
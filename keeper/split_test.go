@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestSplitTransfer tests that a transfer exceeding the max tokens or bytes per
+// packet is split into several packets, each of which is received or refunded
+// independently.
+func (suite *KeeperTestSuite) TestSplitTransfer() {
+	var (
+		classID       = "cryptoCat"
+		tokenIDs      = []string{"kitty1", "kitty2", "kitty3", "kitty4", "kitty5"}
+		refundAddress = sdk.AccAddress([]byte("refund-address______"))
+		forwardMemo   = `{"forward":{"receiver":"receiver","port":"nft-transfer","channel":"channel-1"}}`
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func(params *types.Params)
+		memo      string
+		expTokens [][]string
+		expErr    error
+	}{
+		{
+			"success: no limits",
+			func(params *types.Params) {},
+			"",
+			[][]string{tokenIDs},
+			nil,
+		},
+		{
+			"success: split by max tokens per packet",
+			func(params *types.Params) { params.MaxTokensPerPacket = 2 },
+			"",
+			[][]string{{"kitty1", "kitty2"}, {"kitty3", "kitty4"}, {"kitty5"}},
+			nil,
+		},
+		{
+			"success: split by max packet bytes",
+			func(params *types.Params) { params.MaxPacketBytes = 600 },
+			"",
+			[][]string{{"kitty1", "kitty2"}, {"kitty3", "kitty4"}, {"kitty5"}},
+			nil,
+		},
+		{
+			"failure: single token exceeds max packet bytes",
+			func(params *types.Params) { params.MaxPacketBytes = 64 },
+			"",
+			nil,
+			types.ErrPacketTooLarge,
+		},
+		{
+			"success: plain memo carried by every packet",
+			func(params *types.Params) { params.MaxTokensPerPacket = 2 },
+			"memo",
+			[][]string{{"kitty1", "kitty2"}, {"kitty3", "kitty4"}, {"kitty5"}},
+			nil,
+		},
+		{
+			"failure: forward memo with split tokens",
+			func(params *types.Params) { params.MaxTokensPerPacket = 2 },
+			forwardMemo,
+			nil,
+			types.ErrPacketTooLarge,
+		},
+		{
+			"failure: source callback memo with split tokens",
+			func(params *types.Params) { params.MaxTokensPerPacket = 2 },
+			`{"src_callback":{"address":"contract"}}`,
+			nil,
+			types.ErrPacketTooLarge,
+		},
+		{
+			"failure: destination callback memo with split tokens",
+			func(params *types.Params) { params.MaxPacketBytes = 600 },
+			`{"dest_callback":{"address":"contract"}}`,
+			nil,
+			types.ErrPacketTooLarge,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs(classID, tokenIDs...)
+
+			nftTransferKeeper := suite.GetSimApp(suite.chainA).NFTTransferKeeper
+			params := nftTransferKeeper.GetParams(suite.chainA.GetContext())
+			tc.malleate(&params)
+			suite.Require().NoError(nftTransferKeeper.SetParams(suite.chainA.GetContext(), params))
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				classID,
+				tokenIDs,
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.ZeroHeight(),
+				uint64(suite.chainB.GetContext().BlockTime().Add(time.Minute*10).UnixNano()),
+				tc.memo,
+			)
+			msg.RefundAddress = refundAddress.String()
+
+			ctx := suite.chainA.GetContext()
+			res, err := nftTransferKeeper.Transfer(ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+
+				// nothing is escrowed nor sent
+				nftKeeper := suite.GetSimApp(suite.chainA).NFTKeeper
+				for _, tokenID := range tokenIDs {
+					suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), nftKeeper.GetOwner(ctx, classID, tokenID))
+				}
+				suite.Require().Empty(nftTransferKeeper.GetAllRefundRecords(ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(res.Sequences[0], res.Sequence)
+
+			// the tokens are sent in order, within the limits
+			channelKeeper := suite.GetSimApp(suite.chainA).IBCKeeper.ChannelKeeper
+			var sentTokens []string
+			for i, sequence := range res.Sequences {
+				suite.Require().Equal(uint64(i+1), sequence)
+				suite.Require().True(channelKeeper.HasPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence))
+
+				record, found := nftTransferKeeper.GetRefundRecord(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().True(found)
+				suite.Require().Equal(refundAddress.String(), record.RefundAddress)
+			}
+			packets, err := ibctesting.ParsePacketsFromEvents(ctx.EventManager().ABCIEvents())
+			suite.Require().NoError(err)
+			suite.Require().Len(packets, len(res.Sequences))
+
+			var packetTokens [][]string
+			for _, packet := range packets {
+				var data types.NonFungibleTokenPacketData
+				suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+				if params.MaxTokensPerPacket != 0 {
+					suite.Require().LessOrEqual(uint64(len(data.TokenIds)), params.MaxTokensPerPacket)
+				}
+				if params.MaxPacketBytes != 0 {
+					suite.Require().LessOrEqual(uint64(len(packet.GetData())), params.MaxPacketBytes)
+				}
+				suite.Require().Equal(tc.memo, data.Memo)
+				packetTokens = append(packetTokens, data.TokenIds)
+				sentTokens = append(sentTokens, data.TokenIds...)
+			}
+			suite.Require().Equal(tokenIDs, sentTokens)
+			suite.Require().Equal(tc.expTokens, packetTokens)
+
+			// the first packet times out while the others are received
+			suite.coordinator.CommitBlock(suite.chainA)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			for _, packet := range packets[1:] {
+				suite.Require().NoError(path.RelayPacket(packet))
+			}
+			suite.coordinator.IncrementTimeBy(time.Minute * 10)
+			suite.coordinator.CommitBlock(suite.chainB)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			suite.Require().NoError(path.EndpointA.TimeoutPacket(packets[0]))
+
+			ctx = suite.chainA.GetContext()
+			nftKeeper := suite.GetSimApp(suite.chainA).NFTKeeper
+			for _, tokenID := range packetTokens[0] {
+				suite.Require().Equal(refundAddress, nftKeeper.GetOwner(ctx, classID, tokenID))
+			}
+
+			classTrace := types.ParseClassTrace(
+				types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + classID,
+			)
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			for _, tokenIDs := range packetTokens[1:] {
+				for _, tokenID := range tokenIDs {
+					suite.Require().Equal(escrowAddress, nftKeeper.GetOwner(ctx, classID, tokenID))
+					suite.Require().Equal(suite.chainB.SenderAccount.GetAddress(),
+						suite.GetSimApp(suite.chainB).NFTKeeper.GetOwner(suite.chainB.GetContext(), classTrace.IBCClassID(), tokenID))
+				}
+			}
+			suite.Require().Empty(nftTransferKeeper.GetAllRefundRecords(ctx))
+		})
+	}
+}
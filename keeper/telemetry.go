@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
+
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// Prometheus metric labels.
+const (
+	labelSuccess   = "success"
+	labelCodespace = "codespace"
+	labelCode      = "code"
+)
+
+// GetPacketSendHeight returns the height at which the packet with the given
+// port, channel and sequence was sent.
+func (k Keeper) GetPacketSendHeight(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketSendHeight, bool) {
+	sendHeight, err := k.packetSendHeights.Get(ctx, collections.Join3(portID, channelID, sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.PacketSendHeight{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return sendHeight, true
+}
+
+// SetPacketSendHeight stores the height at which a packet was sent.
+func (k Keeper) SetPacketSendHeight(ctx sdk.Context, sendHeight types.PacketSendHeight) {
+	if err := k.packetSendHeights.Set(ctx, collections.Join3(sendHeight.PortId, sendHeight.ChannelId, sendHeight.Sequence), sendHeight); err != nil {
+		panic(err)
+	}
+}
+
+// DeletePacketSendHeight removes the height at which the packet with the given
+// port, channel and sequence was sent.
+func (k Keeper) DeletePacketSendHeight(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	if err := k.packetSendHeights.Remove(ctx, collections.Join3(portID, channelID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllPacketSendHeights returns the heights at which all the packets not
+// acknowledged yet were sent.
+func (k Keeper) GetAllPacketSendHeights(ctx sdk.Context) []types.PacketSendHeight {
+	sendHeights := []types.PacketSendHeight{}
+	err := k.packetSendHeights.Walk(ctx, nil, func(_ collections.Triple[string, string, uint64], sendHeight types.PacketSendHeight) (bool, error) {
+		sendHeights = append(sendHeights, sendHeight)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sendHeights
+}
+
+// ReportErrorAcknowledgement reports the error acknowledgement written for the
+// given packet by the error code of the receive failure.
+func ReportErrorAcknowledgement(packet channeltypes.Packet, err error) {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	telemetry.IncrCounterWithLabels(
+		[]string{"ibc", types.ModuleName, "acknowledgement", "error"},
+		1,
+		append(channelLabels(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel()),
+			telemetry.NewLabel(labelCodespace, codespace),
+			telemetry.NewLabel(labelCode, strconv.FormatUint(uint64(code), 10)),
+		),
+	)
+}
+
+// reportPacketResolved reports the acknowledgement or the timeout of the given
+// packet sent, along with its latency in blocks, and removes its send height.
+func (k Keeper) reportPacketResolved(ctx sdk.Context, packet channeltypes.Packet, keys []string, labels []metrics.Label) {
+	telemetry.IncrCounterWithLabels(keys, 1, labels)
+
+	sendHeight, found := k.GetPacketSendHeight(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+	k.DeletePacketSendHeight(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	addSampleWithLabels(append(keys, "latency_blocks"), float32(ctx.BlockHeight()-sendHeight.Height), labels)
+}
+
+// packetLabels returns the labels of the metrics of the given packet: its
+// source and destination channels and whether this chain is the source of the
+// tokens, i.e. whether the tokens are native to this chain.
+func packetLabels(packet channeltypes.Packet, source bool) []metrics.Label {
+	return append(
+		channelLabels(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel()),
+		telemetry.NewLabel(coretypes.LabelSource, strconv.FormatBool(source)),
+	)
+}
+
+// channelLabels returns the labels of the source and destination channels of
+// the metrics of a packet.
+func channelLabels(sourcePort, sourceChannel, destinationPort, destinationChannel string) []metrics.Label {
+	return []metrics.Label{
+		telemetry.NewLabel(coretypes.LabelSourcePort, sourcePort),
+		telemetry.NewLabel(coretypes.LabelSourceChannel, sourceChannel),
+		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
+		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+	}
+}
+
+// addSampleWithLabels adds a sample to a histogram if telemetry is enabled.
+func addSampleWithLabels(keys []string, val float32, labels []metrics.Label) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+	metrics.AddSampleWithLabels(keys, val, labels)
+}
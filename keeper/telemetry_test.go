@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestTelemetry tests that the send height of the packets is recorded until
+// they are resolved, and that the metrics of the send, receipt, acknowledgement,
+// timeout and refund of the packets are reported.
+func (suite *KeeperTestSuite) TestTelemetry() {
+	suite.SetupTest() // reset
+
+	_, err := telemetry.New(telemetry.Config{Enabled: true})
+	suite.Require().NoError(err)
+	defer telemetry.New(telemetry.Config{Enabled: false}) //nolint:errcheck
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	metricsConf := metrics.DefaultConfig("")
+	metricsConf.EnableHostname = false
+	metricsConf.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(metricsConf, sink)
+	suite.Require().NoError(err)
+
+	classID := "cryptoCat"
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	suite.mintNFTs(classID, "kitty1", "kitty2", "kitty3")
+
+	keeperA := suite.GetSimApp(suite.chainA).NFTTransferKeeper
+	send := func(tokenID string, timeoutTimestamp uint64) channeltypes.Packet {
+		timeoutHeight := suite.chainB.GetTimeoutHeight()
+		if timeoutTimestamp != 0 {
+			timeoutHeight = clienttypes.ZeroHeight()
+		}
+		msg := types.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			classID,
+			[]string{tokenID},
+			suite.chainA.SenderAccount.GetAddress().String(),
+			suite.chainB.SenderAccount.GetAddress().String(),
+			timeoutHeight,
+			timeoutTimestamp,
+			"",
+		)
+		height := suite.chainA.GetContext().BlockHeight()
+		res, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		suite.Require().NoError(err)
+
+		sendHeight, found := keeperA.GetPacketSendHeight(suite.chainA.GetContext(),
+			packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		suite.Require().True(found)
+		suite.Require().Equal(types.NewPacketSendHeight(
+			packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), height,
+		), sendHeight)
+		return packet
+	}
+
+	// success acknowledgement
+	suite.Require().NoError(path.RelayPacket(send("kitty1", 0)))
+
+	// error acknowledgement
+	suite.Require().NoError(suite.GetSimApp(suite.chainB).NFTTransferKeeper.SetParams(
+		suite.chainB.GetContext(), types.NewParams(true, false),
+	))
+	suite.Require().NoError(path.RelayPacket(send("kitty2", 0)))
+
+	// timeout
+	packet := send("kitty3", uint64(suite.chainB.GetContext().BlockTime().Add(time.Second).UnixNano()))
+	suite.coordinator.IncrementTimeBy(time.Minute)
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().Empty(keeperA.GetAllPacketSendHeights(suite.chainA.GetContext()))
+
+	counters, samples := map[string]float64{}, map[string]int{}
+	for _, interval := range sink.Data() {
+		for _, counter := range interval.Counters {
+			counters[counter.Name] += counter.Sum
+		}
+		for _, sample := range interval.Samples {
+			samples[sample.Name] += sample.Count
+		}
+	}
+	prefix := "ibc." + types.ModuleName + "."
+	suite.Require().Equal(float64(3), counters[prefix+"send"])
+	suite.Require().Equal(float64(1), counters[prefix+"receive"])
+	suite.Require().Equal(float64(1), counters[prefix+"receive.tokens"])
+	suite.Require().Equal(float64(1), counters[prefix+"vouchers.minted"])
+	suite.Require().Equal(float64(1), counters[prefix+"acknowledgement.error"])
+	suite.Require().Equal(float64(2), counters[prefix+"acknowledgement"])
+	suite.Require().Equal(float64(1), counters[prefix+"timeout"])
+	suite.Require().Equal(float64(2), counters[prefix+"refund"])
+	suite.Require().Equal(float64(2), counters[prefix+"refund.tokens"])
+	suite.Require().Equal(float64(2), counters[prefix+"tokens.unescrowed"])
+	suite.Require().Equal(3, samples[prefix+"send.tokens_per_packet"])
+	suite.Require().Equal(1, samples[prefix+"receive.tokens_per_packet"])
+	suite.Require().Equal(2, samples[prefix+"acknowledgement.latency_blocks"])
+	suite.Require().Equal(1, samples[prefix+"timeout.latency_blocks"])
+}
@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"errors"
+	"strings"
+
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
-	"cosmossdk.io/store/prefix"
-	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -14,13 +16,13 @@ import (
 
 // GetClassTrace retrieves the full identifiers trace and base classId from the store.
 func (k Keeper) GetClassTrace(ctx sdk.Context, classTraceHash tmbytes.HexBytes) (types.ClassTrace, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
-	bz := store.Get(classTraceHash)
-	if bz == nil {
+	classTrace, err := k.classTraces.Get(ctx, classTraceHash)
+	if errors.Is(err, collections.ErrNotFound) {
 		return types.ClassTrace{}, false
 	}
-
-	classTrace := k.MustUnmarshalClassTrace(bz)
+	if err != nil {
+		panic(err)
+	}
 	return classTrace, true
 }
 
@@ -38,47 +40,64 @@ func (k Keeper) GetAllClassTraces(ctx sdk.Context) types.Traces {
 // IterateClassTraces iterates over the class traces in the store
 // and performs a callback function.
 func (k Keeper) IterateClassTraces(ctx sdk.Context, cb func(_ types.ClassTrace) bool) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, types.ClassTraceKey)
-
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		classTrace := k.MustUnmarshalClassTrace(iterator.Value())
-		if cb(classTrace) {
-			break
-		}
+	err := k.classTraces.Walk(ctx, nil, func(_ []byte, classTrace types.ClassTrace) (bool, error) {
+		return cb(classTrace), nil
+	})
+	if err != nil {
+		panic(err)
 	}
 }
 
 // ClassPathFromHash returns the full class path prefix from an ibc classId with a hash
 // component.
 func (k Keeper) ClassPathFromHash(ctx sdk.Context, classID string) (string, error) {
+	classTrace, err := k.ClassTraceFromHash(ctx, classID)
+	if err != nil {
+		return "", err
+	}
+	return classTrace.GetFullClassPath(), nil
+}
+
+// ClassTraceFromHash returns the class trace from an ibc classId with a hash
+// component.
+func (k Keeper) ClassTraceFromHash(ctx sdk.Context, classID string) (types.ClassTrace, error) {
 	// trim the class prefix, by default "ibc/"
 	hexHash := classID[len(types.ClassPrefix+"/"):]
 
 	hash, err := types.ParseHexHash(hexHash)
 	if err != nil {
-		return "", errorsmod.Wrap(types.ErrInvalidClassID, err.Error())
+		return types.ClassTrace{}, errorsmod.Wrap(types.ErrInvalidClassID, err.Error())
 	}
 
 	classTrace, found := k.GetClassTrace(ctx, hash)
 	if !found {
-		return "", errorsmod.Wrap(types.ErrTraceNotFound, hexHash)
+		return types.ClassTrace{}, errorsmod.Wrap(types.ErrTraceNotFound, hexHash)
 	}
-	return classTrace.GetFullClassPath(), nil
+	return classTrace, nil
+}
+
+// getClassTrace returns the class trace of the given classID on this chain.
+func (k Keeper) getClassTrace(ctx sdk.Context, classID string) (types.ClassTrace, error) {
+	if strings.HasPrefix(classID, types.ClassPrefix+"/") {
+		return k.ClassTraceFromHash(ctx, classID)
+	}
+	return types.ClassTrace{BaseClassId: classID}, nil
 }
 
 // HasClassTrace checks if a the key with the given denomination trace hash exists on the store.
 func (k Keeper) HasClassTrace(ctx sdk.Context, classTraceHash tmbytes.HexBytes) bool {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
-	return store.Has(classTraceHash)
+	has, err := k.classTraces.Has(ctx, classTraceHash)
+	if err != nil {
+		panic(err)
+	}
+	return has
 }
 
 // SetClassTrace sets a new {trace hash -> class trace} pair to the store.
 func (k Keeper) SetClassTrace(ctx sdk.Context, classTrace types.ClassTrace) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
-	bz := k.MustMarshalClassTrace(classTrace)
-	store.Set(classTrace.Hash(), bz)
+	if err := k.classTraces.Set(ctx, classTrace.Hash(), classTrace); err != nil {
+		panic(err)
+	}
 }
 
 // MustUnmarshalClassTrace attempts to decode and return an ClassTrace object from
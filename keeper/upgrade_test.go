@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// TestChannelUpgrade tests that an nft-transfer channel holding escrowed tokens
+// can be upgraded to new connection hops or to the ics721-2 version and that the
+// tokens can still return over the upgraded channel.
+func (suite *KeeperTestSuite) TestChannelUpgrade() {
+	var (
+		path           *ibctesting.Path
+		upgradePath    *ibctesting.Path
+		classID        = "cryptoCat"
+		voucherClassID string
+		expVersion     string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: upgrade to ics721-2", func() {
+				path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = types.V2
+				path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = types.V2
+				expVersion = types.V2
+			}, nil,
+		},
+		{
+			"invalid proposed ordering", func() {
+				path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Ordering = channeltypes.ORDERED
+			},
+			channeltypes.ErrInvalidChannelOrdering,
+		},
+		{
+			"invalid proposed version", func() {
+				path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = "ics721-0"
+			},
+			types.ErrInvalidVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expVersion = types.Version
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.mintNFTs(classID, "kitty1")
+			voucherClassID = types.ParseClassTrace(
+				types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + classID,
+			).IBCClassID()
+
+			// escrow the token on chainA before the upgrade
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				classID,
+				[]string{"kitty1"},
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(),
+				0,
+				"",
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.Require().NoError(path.RelayPacket(packet))
+
+			// upgrade the channel to a new pair of connections
+			upgradePath = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(upgradePath)
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.ConnectionHops = []string{upgradePath.EndpointA.ConnectionID}
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.ConnectionHops = []string{upgradePath.EndpointB.ConnectionID}
+
+			tc.malleate()
+
+			err = path.EndpointA.ChanUpgradeInit()
+			if tc.expErr != nil {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+				suite.Require().Equal(channeltypes.OPEN, path.EndpointA.GetChannel().State)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+			suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+			suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+			suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+			channelA := path.EndpointA.GetChannel()
+			suite.Require().Equal(channeltypes.OPEN, channelA.State)
+			suite.Require().Equal([]string{upgradePath.EndpointA.ConnectionID}, channelA.ConnectionHops)
+			suite.Require().Equal(expVersion, channelA.Version)
+
+			// relay over the clients of the upgraded connections
+			path.EndpointA.ConnectionID, path.EndpointA.ClientID = upgradePath.EndpointA.ConnectionID, upgradePath.EndpointA.ClientID
+			path.EndpointB.ConnectionID, path.EndpointB.ClientID = upgradePath.EndpointB.ConnectionID, upgradePath.EndpointB.ClientID
+
+			// the escrowed token returns to chainA over the upgraded channel
+			msg = types.NewMsgTransfer(
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				voucherClassID,
+				[]string{"kitty1"},
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainA.GetTimeoutHeight(),
+				0,
+				"",
+			)
+			res, err = suite.chainB.SendMsgs(msg)
+			suite.Require().NoError(err)
+			packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.Require().NoError(path.RelayPacket(packet))
+
+			suite.Require().Equal(
+				suite.chainA.SenderAccount.GetAddress(),
+				suite.GetSimApp(suite.chainA).NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, "kitty1"),
+			)
+			suite.Require().Empty(suite.GetSimApp(suite.chainA).NFTTransferKeeper.GetAllEscrowedTokens(suite.chainA.GetContext()))
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+var feeTransferVersionV2 = string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{
+	FeeVersion: feetypes.Version,
+	AppVersion: types.V2,
+}))
+
+// NewTransferPathV2 returns a path whose channel is opened with the ics721-2 version.
+func NewTransferPathV2(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := NewTransferPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.Version = types.V2
+	path.EndpointB.ChannelConfig.Version = types.V2
+	return path
+}
+
+// TestTransferV2 tests that a class whose base classID looks like a class trace
+// keeps its trace over ics721-2 channels, A -> B -> C -> B -> A, and that the
+// tokens are refunded when a packet times out.
+func (suite *KeeperTestSuite) TestTransferV2() {
+	suite.SetupTest() // reset
+
+	// the base classID would be parsed as a class trace from a v1 packet
+	classID := "cat/channel-1"
+	suite.mintNFTs(classID, "kitty1")
+
+	pathA2B := NewTransferPathV2(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(pathA2B)
+	pathB2C := NewTransferPathV2(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(pathB2C)
+	suite.Require().Equal(types.V2, pathA2B.EndpointA.GetChannel().Version)
+
+	send := func(from *ibctesting.Endpoint, classID string, timeoutTimestamp uint64) (channeltypes.Packet, types.NonFungibleTokenPacketData) {
+		timeoutHeight := from.Counterparty.Chain.GetTimeoutHeight()
+		if timeoutTimestamp != 0 {
+			timeoutHeight = clienttypes.ZeroHeight()
+		}
+		msg := types.NewMsgTransfer(
+			from.ChannelConfig.PortID,
+			from.ChannelID,
+			classID,
+			[]string{"kitty1"},
+			from.Chain.SenderAccount.GetAddress().String(),
+			from.Counterparty.Chain.SenderAccount.GetAddress().String(),
+			timeoutHeight,
+			timeoutTimestamp,
+			"",
+		)
+		res, err := from.Chain.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		suite.Require().NoError(err)
+
+		var data types.NonFungibleTokenPacketData
+		suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+		suite.Require().True(data.HasClassTrace())
+		return packet, data
+	}
+	requireOwner := func(chain *ibctesting.TestChain, classID string, owner sdk.AccAddress) {
+		suite.Require().Equal(owner, suite.GetSimApp(chain).NFTKeeper.GetOwner(chain.GetContext(), classID, "kitty1"))
+	}
+
+	// A -> B
+	packet, data := send(pathA2B.EndpointA, classID, 0)
+	suite.Require().Equal(types.ClassTrace{BaseClassId: classID}, data.GetClassTrace())
+	suite.Require().NoError(pathA2B.RelayPacket(packet))
+
+	traceB := types.ClassTrace{
+		Path:        fmt.Sprintf("%s/%s", pathA2B.EndpointB.ChannelConfig.PortID, pathA2B.EndpointB.ChannelID),
+		BaseClassId: classID,
+	}
+	_, found := suite.GetSimApp(suite.chainB).NFTTransferKeeper.GetClassTrace(suite.chainB.GetContext(), traceB.Hash())
+	suite.Require().True(found)
+	requireOwner(suite.chainB, traceB.IBCClassID(), suite.chainB.SenderAccount.GetAddress())
+
+	// B -> C times out and the voucher is minted back on B
+	packet, data = send(pathB2C.EndpointA, traceB.IBCClassID(), uint64(suite.chainC.GetContext().BlockTime().Add(time.Second).UnixNano()))
+	suite.Require().Equal(traceB, data.GetClassTrace())
+	suite.coordinator.IncrementTimeBy(time.Minute)
+	suite.coordinator.CommitBlock(suite.chainC)
+	suite.Require().NoError(pathB2C.EndpointA.UpdateClient())
+	suite.Require().NoError(pathB2C.EndpointA.TimeoutPacket(packet))
+	requireOwner(suite.chainB, traceB.IBCClassID(), suite.chainB.SenderAccount.GetAddress())
+
+	// B -> C
+	packet, _ = send(pathB2C.EndpointA, traceB.IBCClassID(), 0)
+	suite.Require().NoError(pathB2C.RelayPacket(packet))
+
+	traceC := traceB.AddPrefix(pathB2C.EndpointB.ChannelConfig.PortID, pathB2C.EndpointB.ChannelID)
+	_, found = suite.GetSimApp(suite.chainC).NFTTransferKeeper.GetClassTrace(suite.chainC.GetContext(), traceC.Hash())
+	suite.Require().True(found)
+	requireOwner(suite.chainC, traceC.IBCClassID(), suite.chainC.SenderAccount.GetAddress())
+
+	// C -> B
+	packet, data = send(pathB2C.EndpointB, traceC.IBCClassID(), 0)
+	suite.Require().Equal(traceC, data.GetClassTrace())
+	suite.Require().NoError(pathB2C.RelayPacket(packet))
+	requireOwner(suite.chainB, traceB.IBCClassID(), suite.chainB.SenderAccount.GetAddress())
+
+	// B -> A
+	packet, _ = send(pathA2B.EndpointB, traceB.IBCClassID(), 0)
+	suite.Require().NoError(pathA2B.RelayPacket(packet))
+	requireOwner(suite.chainA, classID, suite.chainA.SenderAccount.GetAddress())
+	suite.Require().Empty(suite.GetSimApp(suite.chainA).NFTTransferKeeper.GetAllEscrowedTokens(suite.chainA.GetContext()))
+}
+
+// TestRecvPacketVersion tests that the class trace is carried separately if and
+// only if the packet is received over an ics721-2 channel.
+func (suite *KeeperTestSuite) TestRecvPacketVersion() {
+	classID := "cryptoCat"
+
+	testCases := []struct {
+		msg      string
+		version  string
+		hasTrace bool
+		expPass  bool
+	}{
+		{"ics721-1 packet on ics721-1 channel", types.V1, false, true},
+		{"ics721-2 packet on ics721-2 channel", types.V2, true, true},
+		{"ics721-1 packet on ics721-2 channel", types.V2, false, false},
+		{"ics721-2 packet on ics721-1 channel", types.V1, true, false},
+		{"ics721-2 packet on fee enabled ics721-2 channel", feeTransferVersionV2, true, true},
+		{"ics721-1 packet on fee enabled ics721-2 channel", feeTransferVersionV2, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = tc.version
+			path.EndpointB.ChannelConfig.Version = tc.version
+			suite.coordinator.Setup(path)
+
+			data := types.NewNonFungibleTokenPacketData(
+				classID, "", "", []string{"kitty1"}, nil,
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				nil, "",
+			)
+			if tc.hasTrace {
+				data = data.WithClassTrace(types.ClassTrace{BaseClassId: classID})
+			}
+			packet := channeltypes.NewPacket(
+				data.GetBytes(), 1,
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(), 0,
+			)
+
+			// the version is read regardless of the middleware the keeper sends packets through
+			nftTransferKeeper := suite.GetSimApp(suite.chainB).NFTTransferKeeper
+			nftTransferKeeper.WithICS4Wrapper(suite.GetSimApp(suite.chainB).IBCKeeper.ChannelKeeper)
+
+			_, err := nftTransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidPacket)
+			}
+		})
+	}
+}
+
+// TestTransferAfterUpgradeToV2 tests that the vouchers minted over an ics721-1
+// channel are still used after the channel is upgraded to ics721-2, for both the
+// tokens sent over the upgraded channel and the vouchers sent back to chainA.
+func (suite *KeeperTestSuite) TestTransferAfterUpgradeToV2() {
+	suite.SetupTest() // reset
+
+	// the base classID is parsed as a class trace from a v1 packet
+	classID := "cat/channel-1/kitties"
+	suite.mintNFTs(classID, "kitty1", "kitty2")
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	send := func(from *ibctesting.Endpoint, classID, tokenID string) {
+		msg := types.NewMsgTransfer(
+			from.ChannelConfig.PortID,
+			from.ChannelID,
+			classID,
+			[]string{tokenID},
+			from.Chain.SenderAccount.GetAddress().String(),
+			from.Counterparty.Chain.SenderAccount.GetAddress().String(),
+			from.Counterparty.Chain.GetTimeoutHeight(),
+			0,
+			"",
+		)
+		res, err := from.Chain.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		suite.Require().NoError(err)
+		suite.Require().NoError(path.RelayPacket(packet))
+	}
+	requireOwner := func(chain *ibctesting.TestChain, classID, tokenID string, owner sdk.AccAddress) {
+		suite.Require().Equal(owner, suite.GetSimApp(chain).NFTKeeper.GetOwner(chain.GetContext(), classID, tokenID))
+	}
+
+	// A -> B over ics721-1
+	send(path.EndpointA, classID, "kitty1")
+	voucherClassID := types.ParseClassTrace(
+		types.GetClassPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID) + classID,
+	).IBCClassID()
+	requireOwner(suite.chainB, voucherClassID, "kitty1", suite.chainB.SenderAccount.GetAddress())
+
+	// upgrade the channel to ics721-2
+	path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = types.V2
+	path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = types.V2
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+	suite.Require().Equal(types.V2, path.EndpointA.GetChannel().Version)
+
+	// A -> B over ics721-2 adds the token to the voucher class minted over ics721-1
+	send(path.EndpointA, classID, "kitty2")
+	requireOwner(suite.chainB, voucherClassID, "kitty2", suite.chainB.SenderAccount.GetAddress())
+
+	// B -> A over ics721-2 unescrows the tokens
+	send(path.EndpointB, voucherClassID, "kitty1")
+	send(path.EndpointB, voucherClassID, "kitty2")
+	requireOwner(suite.chainA, classID, "kitty1", suite.chainA.SenderAccount.GetAddress())
+	requireOwner(suite.chainA, classID, "kitty2", suite.chainA.SenderAccount.GetAddress())
+	suite.Require().Empty(suite.GetSimApp(suite.chainA).NFTTransferKeeper.GetAllEscrowedTokens(suite.chainA.GetContext()))
+}
+
+// TestFeeMiddlewareV2 tests that the fee middleware passes the ics721-2 version
+// to the module, which then sends and receives the class trace separately.
+func (suite *KeeperTestSuite) TestFeeMiddlewareV2() {
+	suite.SetupTest() // reset
+
+	classID := "cryptoCat"
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = feeTransferVersionV2
+	path.EndpointB.ChannelConfig.Version = feeTransferVersionV2
+	suite.coordinator.Setup(path)
+	suite.mintNFTs(classID, "kitty1")
+
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		classID,
+		[]string{"kitty1"},
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(),
+		0,
+		"",
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var data types.NonFungibleTokenPacketData
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+	suite.Require().True(data.HasClassTrace())
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	voucherClassID := types.ClassTrace{BaseClassId: classID}.
+		AddPrefix(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID).IBCClassID()
+	suite.Require().Equal(
+		suite.chainB.SenderAccount.GetAddress(),
+		suite.GetSimApp(suite.chainB).NFTKeeper.GetOwner(suite.chainB.GetContext(), voucherClassID, "kitty1"),
+	)
+}
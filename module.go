@@ -10,24 +10,34 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/bianjieai/nft-transfer/client/cli"
 	"github.com/bianjieai/nft-transfer/keeper"
 	"github.com/bianjieai/nft-transfer/simulation"
 	"github.com/bianjieai/nft-transfer/types"
+	modulev1 "github.com/bianjieai/nft-transfer/types/module/v1"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 )
 
 var (
-	_ module.AppModule      = (*AppModule)(nil)
-	_ module.AppModuleBasic = (*AppModuleBasic)(nil)
-	_ porttypes.IBCModule   = (*IBCModule)(nil)
+	_ module.AppModule           = (*AppModule)(nil)
+	_ module.AppModuleBasic      = (*AppModuleBasic)(nil)
+	_ module.AppModuleSimulation = (*AppModule)(nil)
+	_ module.HasProposalMsgs     = (*AppModule)(nil)
+	_ porttypes.IBCModule        = (*IBCModule)(nil)
+	_ appmodule.AppModule        = (*AppModule)(nil)
 )
 
 // AppModuleBasic is the IBC nft-transfer AppModuleBasic
@@ -85,13 +95,17 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 // AppModule represents the AppModule for this module
 type AppModule struct {
 	AppModuleBasic
-	keeper keeper.Keeper
+	keeper        keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 }
 
 // NewAppModule creates a new nft-transfer module
-func NewAppModule(k keeper.Keeper) AppModule {
+func NewAppModule(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) AppModule {
 	return AppModule{
-		keeper: k,
+		keeper:        k,
+		accountKeeper: ak,
+		bankKeeper:    bk,
 	}
 }
 
@@ -102,12 +116,22 @@ func (AppModule) IsOnePerModuleType() {}
 func (AppModule) IsAppModule() {}
 
 // RegisterInvariants implements the AppModule interface
-func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate nft-transfer from version 1 to 2: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate nft-transfer from version 2 to 3: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc nft-transfer module. It returns
@@ -127,7 +151,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // GenerateGenesisState creates a randomized GenState of the nft-transfer module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
@@ -139,7 +163,90 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 	sdr[types.StoreKey] = simulation.NewDecodeStore(am.keeper)
 }
 
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs(am.keeper)
+}
+
 // WeightedOperations returns the all the nft-transfer module operations with their respective weights.
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.TxConfig, am.keeper, am.accountKeeper, am.bankKeeper)
+}
+
+//
+// App Wiring Setup
+//
+
+func init() {
+	appmodule.Register(
+		&modulev1.Module{},
+		appmodule.Provide(ProvideModule),
+	)
+}
+
+// ModuleInputs are the dependencies of the nft-transfer module injected by
+// depinject. The IBC keepers are not provided by ibc-go and must be supplied by
+// the app, along with the NFTKeeper, the ICS4Wrapper the packets are sent through
+// wrapped in a ModuleICS4Wrapper and the capability keeper scoped to the module
+// wrapped in a ScopedKeeper, so that they are not mistaken for those of the other
+// IBC applications. The BankKeeper is only used by the simulation to pay the fees
+// of the delivered transactions.
+type ModuleInputs struct {
+	depinject.In
+
+	Config       *modulev1.Module
+	Cdc          codec.Codec
+	StoreService store.KVStoreService
+
+	ICS4Wrapper   types.ModuleICS4Wrapper
+	ChannelKeeper types.ChannelKeeper
+	ClientKeeper  types.ClientKeeper
+	PortKeeper    types.PortKeeper
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
+	NFTKeeper     types.NFTKeeper
+	ScopedKeeper  types.ScopedKeeper
+
+	CircuitBreaker types.CircuitBreaker `optional:"true"`
+	Hooks          types.TransferHooks  `optional:"true"`
+}
+
+// ModuleOutputs are the keeper and the app module provided by the nft-transfer
+// module.
+type ModuleOutputs struct {
+	depinject.Out
+
+	NFTTransferKeeper keeper.Keeper
+	Module            appmodule.AppModule
+}
+
+// ProvideModule creates the nft-transfer keeper and app module from the
+// injected dependencies.
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	k := keeper.NewKeeper(
+		in.Cdc,
+		in.StoreService,
+		authority.String(),
+		in.ICS4Wrapper.ICS4Wrapper,
+		in.ChannelKeeper,
+		in.ClientKeeper,
+		in.PortKeeper,
+		in.AccountKeeper,
+		in.NFTKeeper,
+		in.ScopedKeeper.ScopedKeeper,
+	)
+	if in.CircuitBreaker != nil {
+		k.SetCircuitBreaker(in.CircuitBreaker)
+	}
+	if in.Hooks != nil {
+		k.SetHooks(in.Hooks)
+	}
+
+	return ModuleOutputs{NFTTransferKeeper: k, Module: NewAppModule(k, in.AccountKeeper, in.BankKeeper)}
 }
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/bianjieai/nft-transfer/types"
@@ -15,7 +18,7 @@ type TransferUnmarshaler interface {
 }
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
-// Value to the corresponding ClassTrace type.
+// Value to the corresponding nft-transfer type.
 func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
@@ -27,8 +30,53 @@ func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 			classTraceB := cdc.MustUnmarshalClassTrace(kvB.Value)
 			return fmt.Sprintf("ClassTrace A: %s\nClassTrace B: %s", classTraceA.IBCClassID(), classTraceB.IBCClassID())
 
+		case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+			return decodePair("Params", kvA, kvB, &types.Params{}, &types.Params{})
+
+		case bytes.Equal(kvA.Key[:1], types.InFlightPacketKey):
+			return decodePair("InFlightPacket", kvA, kvB, &types.InFlightPacket{}, &types.InFlightPacket{})
+
+		case bytes.Equal(kvA.Key[:1], types.RateLimitKey):
+			return decodePair("RateLimit", kvA, kvB, &types.RateLimit{}, &types.RateLimit{})
+
+		case bytes.Equal(kvA.Key[:1], types.RateLimitFlowKey):
+			return decodePair("RateLimitFlow", kvA, kvB, &types.RateLimitFlow{}, &types.RateLimitFlow{})
+
+		case bytes.Equal(kvA.Key[:1], types.PendingSendPacketKey):
+			return decodePair("PendingSendPacket", kvA, kvB, &types.PendingSendPacket{}, &types.PendingSendPacket{})
+
+		case bytes.Equal(kvA.Key[:1], types.EscrowedTokenKey), bytes.Equal(kvA.Key[:1], types.TokenEscrowKey):
+			return decodePair("EscrowedToken", kvA, kvB, &types.EscrowedToken{}, &types.EscrowedToken{})
+
+		case bytes.Equal(kvA.Key[:1], types.EscrowedClassCountKey):
+			return decodePair("EscrowedClassCount", kvA, kvB, &types.EscrowedClassCount{}, &types.EscrowedClassCount{})
+
+		case bytes.Equal(kvA.Key[:1], types.RefundRecordKey):
+			return decodePair("RefundRecord", kvA, kvB, &types.RefundRecord{}, &types.RefundRecord{})
+
+		case bytes.Equal(kvA.Key[:1], types.PacketSendHeightKey):
+			return decodePair("PacketSendHeight", kvA, kvB, &types.PacketSendHeight{}, &types.PacketSendHeight{})
+
+		case bytes.Equal(kvA.Key[:1], types.EscrowReleaseRecordKey):
+			return decodePair("EscrowReleaseRecord", kvA, kvB, &types.EscrowReleaseRecord{}, &types.EscrowReleaseRecord{})
+
+		case bytes.Equal(kvA.Key[:1], types.NextEscrowReleaseIDKey):
+			return fmt.Sprintf("NextEscrowReleaseID A: %d\nNextEscrowReleaseID B: %d",
+				sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.PauseKey):
+			return decodePair("Pause", kvA, kvB, &types.Pause{}, &types.Pause{})
+
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
 	}
 }
+
+// decodePair unmarshals the values of both KVPairs into the given messages and
+// formats them under the given name.
+func decodePair(name string, kvA, kvB kv.Pair, msgA, msgB proto.Message) string {
+	types.ModuleCdc.MustUnmarshal(kvA.Value, msgA)
+	types.ModuleCdc.MustUnmarshal(kvB.Value, msgB)
+	return fmt.Sprintf("%s A: %v\n%s B: %v", name, msgA, name, msgB)
+}
@@ -1,59 +1,102 @@
 package simulation_test
 
-// import (
-// 	"fmt"
-// 	"testing"
-
-// 	"github.com/cosmos/cosmos-sdk/types/kv"
-// 	"github.com/stretchr/testify/require"
-
-// 	"github.com/bianjieai/nft-transfer/simulation"
-// 	"github.com/bianjieai/nft-transfer/testing/simapp"
-// 	"github.com/bianjieai/nft-transfer/types"
-// )
-
-// func TestDecodeStore(t *testing.T) {
-// 	app := simapp.Setup(false)
-// 	dec := simulation.NewDecodeStore(app.NFTTransferKeeper)
-
-// 	trace := types.ClassTrace{
-// 		BaseClassId: "kitty",
-// 		Path:        "nft-transfer/channelToA",
-// 	}
-
-// 	kvPairs := kv.Pairs{
-// 		Pairs: []kv.Pair{
-// 			{
-// 				Key:   types.PortKey,
-// 				Value: []byte(types.PortID),
-// 			},
-// 			{
-// 				Key:   types.ClassTraceKey,
-// 				Value: app.NFTTransferKeeper.MustMarshalClassTrace(trace),
-// 			},
-// 			{
-// 				Key:   []byte{0x99},
-// 				Value: []byte{0x99},
-// 			},
-// 		},
-// 	}
-// 	tests := []struct {
-// 		name        string
-// 		expectedLog string
-// 	}{
-// 		{"PortID", fmt.Sprintf("Port A: %s\nPort B: %s", types.PortID, types.PortID)},
-// 		{"ClassTrace", fmt.Sprintf("ClassTrace A: %s\nClassTrace B: %s", trace.IBCClassID(), trace.IBCClassID())},
-// 		{"other", ""},
-// 	}
-
-// 	for i, tt := range tests {
-// 		i, tt := i, tt
-// 		t.Run(tt.name, func(t *testing.T) {
-// 			if i == len(tests)-1 {
-// 				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
-// 			} else {
-// 				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
-// 			}
-// 		})
-// 	}
-// }
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/bianjieai/nft-transfer/simulation"
+	"github.com/bianjieai/nft-transfer/testing/simapp"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	cdc := app.AppCodec()
+	dec := simulation.NewDecodeStore(app.NFTTransferKeeper)
+
+	trace := types.ClassTrace{
+		BaseClassId: "kitty",
+		Path:        "nft-transfer/channelToA",
+	}
+	params := types.NewParams(true, false)
+	inFlightPacket := types.InFlightPacket{
+		ForwardPortId:    types.PortID,
+		ForwardChannelId: "channel-1",
+		ForwardSequence:  1,
+	}
+	rateLimit := types.NewRateLimit(types.PortID, "channel-0", "kitty", 10, 10, time.Hour)
+	flow := types.NewRateLimitFlow(types.PortID, "channel-0", "kitty", time.Unix(0, 0).UTC())
+	pendingSendPacket := types.NewPendingSendPacket(types.PortID, "channel-0", 1, "kitty", 2, time.Unix(0, 0).UTC())
+	escrowedToken := types.NewEscrowedToken(types.PortID, "channel-0", "kitty", "kitty1")
+	classCount := types.NewEscrowedClassCount(types.PortID, "channel-0", "kitty", 1)
+	refundRecord := types.NewRefundRecord(types.PortID, "channel-0", 1, "refund")
+	sendHeight := types.NewPacketSendHeight(types.PortID, "channel-0", 1, 10)
+	releaseRecord := types.EscrowReleaseRecord{
+		Id:        1,
+		PortId:    types.PortID,
+		ChannelId: "channel-0",
+		Releases:  []types.EscrowRelease{types.NewEscrowRelease("kitty", "kitty1", "owner")},
+		Evidence:  "evidence",
+		Height:    10,
+	}
+	pause := types.NewPause(types.PortID, "channel-0", "")
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.PortKey, Value: []byte(types.PortID)},
+			{Key: types.ClassTraceKey, Value: app.NFTTransferKeeper.MustMarshalClassTrace(trace)},
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: types.InFlightPacketKey, Value: cdc.MustMarshal(&inFlightPacket)},
+			{Key: types.RateLimitKey, Value: cdc.MustMarshal(&rateLimit)},
+			{Key: types.RateLimitFlowKey, Value: cdc.MustMarshal(&flow)},
+			{Key: types.PendingSendPacketKey, Value: cdc.MustMarshal(&pendingSendPacket)},
+			{Key: types.EscrowedTokenKey, Value: cdc.MustMarshal(&escrowedToken)},
+			{Key: types.TokenEscrowKey, Value: cdc.MustMarshal(&escrowedToken)},
+			{Key: types.EscrowedClassCountKey, Value: cdc.MustMarshal(&classCount)},
+			{Key: types.RefundRecordKey, Value: cdc.MustMarshal(&refundRecord)},
+			{Key: types.PacketSendHeightKey, Value: cdc.MustMarshal(&sendHeight)},
+			{Key: types.EscrowReleaseRecordKey, Value: cdc.MustMarshal(&releaseRecord)},
+			{Key: types.NextEscrowReleaseIDKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.PauseKey, Value: cdc.MustMarshal(&pause)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"PortID", fmt.Sprintf("Port A: %s\nPort B: %s", types.PortID, types.PortID)},
+		{"ClassTrace", fmt.Sprintf("ClassTrace A: %s\nClassTrace B: %s", trace.IBCClassID(), trace.IBCClassID())},
+		{"Params", fmt.Sprintf("Params A: %v\nParams B: %v", &params, &params)},
+		{"InFlightPacket", fmt.Sprintf("InFlightPacket A: %v\nInFlightPacket B: %v", &inFlightPacket, &inFlightPacket)},
+		{"RateLimit", fmt.Sprintf("RateLimit A: %v\nRateLimit B: %v", &rateLimit, &rateLimit)},
+		{"RateLimitFlow", fmt.Sprintf("RateLimitFlow A: %v\nRateLimitFlow B: %v", &flow, &flow)},
+		{"PendingSendPacket", fmt.Sprintf("PendingSendPacket A: %v\nPendingSendPacket B: %v", &pendingSendPacket, &pendingSendPacket)},
+		{"EscrowedToken", fmt.Sprintf("EscrowedToken A: %v\nEscrowedToken B: %v", &escrowedToken, &escrowedToken)},
+		{"TokenEscrow", fmt.Sprintf("EscrowedToken A: %v\nEscrowedToken B: %v", &escrowedToken, &escrowedToken)},
+		{"EscrowedClassCount", fmt.Sprintf("EscrowedClassCount A: %v\nEscrowedClassCount B: %v", &classCount, &classCount)},
+		{"RefundRecord", fmt.Sprintf("RefundRecord A: %v\nRefundRecord B: %v", &refundRecord, &refundRecord)},
+		{"PacketSendHeight", fmt.Sprintf("PacketSendHeight A: %v\nPacketSendHeight B: %v", &sendHeight, &sendHeight)},
+		{"EscrowReleaseRecord", fmt.Sprintf("EscrowReleaseRecord A: %v\nEscrowReleaseRecord B: %v", &releaseRecord, &releaseRecord)},
+		{"NextEscrowReleaseID", "NextEscrowReleaseID A: 2\nNextEscrowReleaseID B: 2"},
+		{"Pause", fmt.Sprintf("Pause A: %v\nPause B: %v", &pause, &pause)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if i == len(tests)-1 {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -13,7 +13,11 @@ import (
 )
 
 // Simulation parameter constants
-const port = "port_id"
+const (
+	port           = "port_id"
+	sendEnabled    = "send_enabled"
+	receiveEnabled = "receive_enabled"
+)
 
 // RadomEnabled randomized send or receive enabled param with 75% prob of being true.
 func RadomEnabled(r *rand.Rand) bool {
@@ -28,9 +32,22 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { portID = strings.ToLower(simtypes.RandStringOfLength(r, 20)) },
 	)
 
+	var enableSend bool
+	simState.AppParams.GetOrGenerate(
+		sendEnabled, &enableSend, simState.Rand,
+		func(r *rand.Rand) { enableSend = RadomEnabled(r) },
+	)
+
+	var enableReceive bool
+	simState.AppParams.GetOrGenerate(
+		receiveEnabled, &enableReceive, simState.Rand,
+		func(r *rand.Rand) { enableReceive = RadomEnabled(r) },
+	)
+
 	transferGenesis := types.GenesisState{
 		PortId: portID,
 		Traces: types.Traces{},
+		Params: types.NewParams(enableSend, enableReceive),
 	}
 
 	bz, err := json.MarshalIndent(&transferGenesis, "", " ")
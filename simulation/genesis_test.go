@@ -43,6 +43,8 @@ func TestRandomizedGenState(t *testing.T) {
 
 	require.Equal(t, "euzxpfgkqegqiqwixnku", ibcTransferGenesis.PortId)
 	require.Len(t, ibcTransferGenesis.Traces, 0)
+	require.True(t, ibcTransferGenesis.Params.SendEnabled)
+	require.True(t, ibcTransferGenesis.Params.ReceiveEnabled)
 
 }
 
@@ -0,0 +1,331 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	localhost "github.com/cosmos/ibc-go/v8/modules/light-clients/09-localhost"
+
+	"github.com/bianjieai/nft-transfer/keeper"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgTransfer = "op_weight_msg_transfer" //nolint:gosec
+
+	DefaultWeightMsgTransfer = 100
+
+	// maxTokensPerTransfer is the maximum number of tokens sent in a single MsgTransfer
+	maxTokensPerTransfer = 10
+	// transferTimeout is the timeout of the packets sent by the simulation
+	transferTimeout = time.Hour
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var weightMsgTransfer int
+	appParams.GetOrGenerate(OpWeightMsgTransfer, &weightMsgTransfer, nil,
+		func(_ *rand.Rand) { weightMsgTransfer = DefaultWeightMsgTransfer },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgTransfer, SimulateMsgTransfer(txGen, k, ak, bk)),
+	}
+}
+
+// SimulateMsgTransfer generates and delivers a MsgTransfer of a random batch of
+// freshly minted tokens over a random open channel of the nft-transfer port. The
+// packets sent over the localhost connection are then received and acknowledged.
+//
+// If the port has no open channel, a channel to itself is first opened over the
+// localhost connection. The simulation does not commit the state changes of the
+// operations, so this happens at most once per block.
+func SimulateMsgTransfer(
+	txGen client.TxConfig,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgTransfer{})
+		relayer, _ := simtypes.RandomAcc(r, accs)
+
+		channels := openChannels(ctx, k)
+		if len(channels) == 0 {
+			if err := openLocalhostChannel(r, app, txGen, ctx, k, ak, bk, relayer); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to open channel"), nil, err
+			}
+			if channels = openChannels(ctx, k); len(channels) == 0 {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "no open channel"), nil, nil
+			}
+		}
+
+		channel := channels[r.Intn(len(channels))]
+		if !k.GetChannelParams(ctx, channel.PortId, channel.ChannelId).SendEnabled {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "send disabled"), nil, nil
+		}
+
+		sender, _ := simtypes.RandomAcc(r, accs)
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		// mint the tokens to the sender before the transfer is delivered
+		nftKeeper := k.GetNFTKeeper()
+		classID := simtypes.RandStringOfLength(r, 10)
+		if err := nftKeeper.CreateOrUpdateClass(ctx, classID, "", ""); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to create class"), nil, nil
+		}
+
+		tokenIDs := make([]string, simtypes.RandIntBetween(r, 1, maxTokensPerTransfer+1))
+		for i := range tokenIDs {
+			tokenIDs[i] = simtypes.RandStringOfLength(r, 10)
+			if err := nftKeeper.Mint(ctx, classID, tokenIDs[i], "", "", sender.Address); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to mint token"), nil, nil
+			}
+		}
+
+		msg := types.NewMsgTransfer(
+			channel.PortId,
+			channel.ChannelId,
+			classID,
+			tokenIDs,
+			sender.Address.String(),
+			receiver.Address.String(),
+			clienttypes.ZeroHeight(),
+			uint64(ctx.BlockTime().Add(transferTimeout).UnixNano()),
+			"",
+		)
+		res, err := deliverTx(r, app, txGen, ctx, ak, bk, sender, msg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		// the packets sent to this chain are relayed right away, so that the
+		// simulation covers the receiving side of the transfer as well
+		if len(channel.ConnectionHops) == 1 && channel.ConnectionHops[0] == ibcexported.LocalhostConnectionID {
+			for _, packet := range parsePackets(res.Events) {
+				if err := relayPacket(r, app, txGen, ctx, ak, bk, relayer, packet); err != nil {
+					return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to relay packet"), nil, err
+				}
+			}
+		}
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// openLocalhostChannel opens a channel of the nft-transfer port to itself over the
+// localhost connection. The handshake is executed by the relayer on both ends of
+// the channel, whose proofs are checked against the store by the localhost client.
+func openLocalhostChannel(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	txGen client.TxConfig,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	relayer simtypes.Account,
+) error {
+	portID := k.GetPort(ctx)
+	connectionHops := []string{ibcexported.LocalhostConnectionID}
+
+	if _, err := deliverTx(r, app, txGen, ctx, ak, bk, relayer, channeltypes.NewMsgChannelOpenInit(
+		portID, types.Version, channeltypes.UNORDERED, connectionHops, portID, relayer.Address.String(),
+	)); err != nil {
+		return err
+	}
+	initChannel, found := findChannel(ctx, k, channeltypes.INIT, "")
+	if !found {
+		return errorsmod.Wrap(channeltypes.ErrChannelNotFound, "init channel")
+	}
+
+	proofHeight := clienttypes.GetSelfHeight(ctx)
+	if _, err := deliverTx(r, app, txGen, ctx, ak, bk, relayer, channeltypes.NewMsgChannelOpenTry(
+		portID, initChannel.Version, channeltypes.UNORDERED, connectionHops, portID, initChannel.ChannelId,
+		initChannel.Version, localhost.SentinelProof, proofHeight, relayer.Address.String(),
+	)); err != nil {
+		return err
+	}
+	tryChannel, found := findChannel(ctx, k, channeltypes.TRYOPEN, initChannel.ChannelId)
+	if !found {
+		return errorsmod.Wrap(channeltypes.ErrChannelNotFound, "try channel")
+	}
+
+	if _, err := deliverTx(r, app, txGen, ctx, ak, bk, relayer, channeltypes.NewMsgChannelOpenAck(
+		portID, initChannel.ChannelId, tryChannel.ChannelId, tryChannel.Version,
+		localhost.SentinelProof, proofHeight, relayer.Address.String(),
+	)); err != nil {
+		return err
+	}
+
+	_, err := deliverTx(r, app, txGen, ctx, ak, bk, relayer, channeltypes.NewMsgChannelOpenConfirm(
+		portID, tryChannel.ChannelId, localhost.SentinelProof, proofHeight, relayer.Address.String(),
+	))
+	return err
+}
+
+// relayPacket delivers the given packet to its destination channel on this chain
+// over the localhost connection and acknowledges it on its source channel.
+func relayPacket(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	txGen client.TxConfig,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	relayer simtypes.Account,
+	packet channeltypes.Packet,
+) error {
+	proofHeight := clienttypes.GetSelfHeight(ctx)
+	res, err := deliverTx(r, app, txGen, ctx, ak, bk, relayer,
+		channeltypes.NewMsgRecvPacket(packet, localhost.SentinelProof, proofHeight, relayer.Address.String()),
+	)
+	if err != nil {
+		return err
+	}
+
+	ack, found := parseAck(res.Events)
+	if !found {
+		// the acknowledgement is written asynchronously
+		return nil
+	}
+	_, err = deliverTx(r, app, txGen, ctx, ak, bk, relayer,
+		channeltypes.NewMsgAcknowledgement(packet, ack, localhost.SentinelProof, proofHeight, relayer.Address.String()),
+	)
+	return err
+}
+
+// deliverTx delivers a transaction with the given message signed by the given
+// account paying random fees, and returns the result of its execution.
+func deliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	txGen client.TxConfig,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+) (*sdk.Result, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, simAccount.Address))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		r,
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		simtestutil.DefaultGenTxGas,
+		ctx.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, res, err := app.SimDeliver(txGen.TxEncoder(), tx)
+	return res, err
+}
+
+// parsePackets returns the packets sent as reported by the given events.
+func parsePackets(events []abci.Event) []channeltypes.Packet {
+	var packets []channeltypes.Packet
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+
+		var packet channeltypes.Packet
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case channeltypes.AttributeKeyDataHex:
+				packet.Data, _ = hex.DecodeString(attr.Value)
+			case channeltypes.AttributeKeySequence:
+				packet.Sequence, _ = strconv.ParseUint(attr.Value, 10, 64)
+			case channeltypes.AttributeKeySrcPort:
+				packet.SourcePort = attr.Value
+			case channeltypes.AttributeKeySrcChannel:
+				packet.SourceChannel = attr.Value
+			case channeltypes.AttributeKeyDstPort:
+				packet.DestinationPort = attr.Value
+			case channeltypes.AttributeKeyDstChannel:
+				packet.DestinationChannel = attr.Value
+			case channeltypes.AttributeKeyTimeoutHeight:
+				packet.TimeoutHeight, _ = clienttypes.ParseHeight(attr.Value)
+			case channeltypes.AttributeKeyTimeoutTimestamp:
+				packet.TimeoutTimestamp, _ = strconv.ParseUint(attr.Value, 10, 64)
+			}
+		}
+		packets = append(packets, packet)
+	}
+	return packets
+}
+
+// parseAck returns the acknowledgement written as reported by the given events.
+func parseAck(events []abci.Event) ([]byte, bool) {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == channeltypes.AttributeKeyAckHex {
+				ack, err := hex.DecodeString(attr.Value)
+				return ack, err == nil
+			}
+		}
+	}
+	return nil, false
+}
+
+// openChannels returns the open channels of the nft-transfer port.
+func openChannels(ctx sdk.Context, k keeper.Keeper) []channeltypes.IdentifiedChannel {
+	portID := k.GetPort(ctx)
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range k.GetChannelKeeper().GetAllChannelsWithPortPrefix(ctx, portID) {
+		if channel.PortId == portID && channel.State == channeltypes.OPEN {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
+// findChannel returns a channel of the nft-transfer port in the given state
+// whose counterparty is the given channel.
+func findChannel(ctx sdk.Context, k keeper.Keeper, state channeltypes.State, counterpartyChannelID string) (channeltypes.IdentifiedChannel, bool) {
+	portID := k.GetPort(ctx)
+	for _, channel := range k.GetChannelKeeper().GetAllChannelsWithPortPrefix(ctx, portID) {
+		if channel.PortId == portID && channel.State == state && channel.Counterparty.ChannelId == counterpartyChannelID {
+			return channel, true
+		}
+	}
+	return channeltypes.IdentifiedChannel{}, false
+}
@@ -0,0 +1,168 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/bianjieai/nft-transfer/simulation"
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/testing/simapp"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+// beginBlock starts the current block of chainA and returns a context on its
+// state, to which the simulated transactions are delivered.
+func (suite *SimTestSuite) beginBlock() sdk.Context {
+	app := suite.chainA.App.(*simapp.SimApp)
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: suite.chainA.CurrentHeader.Height,
+		Time:   suite.chainA.CurrentHeader.GetTime(),
+	})
+	suite.Require().NoError(err)
+	return app.NewContextLegacy(false, suite.chainA.CurrentHeader)
+}
+
+// simAccounts returns the sender accounts of chainA as simulation accounts.
+func (suite *SimTestSuite) simAccounts() []simtypes.Account {
+	accs := make([]simtypes.Account, len(suite.chainA.SenderAccounts))
+	for i, senderAccount := range suite.chainA.SenderAccounts {
+		accs[i] = simtypes.Account{
+			PrivKey: senderAccount.SenderPrivKey,
+			PubKey:  senderAccount.SenderPrivKey.PubKey(),
+			Address: senderAccount.SenderAccount.GetAddress(),
+		}
+	}
+	return accs
+}
+
+func (suite *SimTestSuite) TestSimulateMsgTransferOverLocalhost() {
+	suite.SetupTest() // reset
+
+	app := suite.chainA.App.(*simapp.SimApp)
+	ctx := suite.beginBlock()
+	op := simulation.SimulateMsgTransfer(app.TxConfig(), app.NFTTransferKeeper, app.AccountKeeper, app.BankKeeper)
+
+	for i := int64(0); i < 2; i++ {
+		operationMsg, futureOperations, err := op(rand.New(rand.NewSource(i)), app.BaseApp, ctx, suite.simAccounts(), suite.chainA.ChainID)
+		suite.Require().NoError(err)
+		suite.Require().Nil(futureOperations)
+		suite.Require().True(operationMsg.OK, operationMsg.Comment)
+
+		// a single channel is opened over the localhost connection
+		channels := app.IBCKeeper.ChannelKeeper.GetAllChannelsWithPortPrefix(ctx, types.PortID)
+		suite.Require().Len(channels, 2)
+		for _, channel := range channels {
+			suite.Require().Equal(channeltypes.OPEN, channel.State)
+			suite.Require().Equal([]string{ibcexported.LocalhostConnectionID}, channel.ConnectionHops)
+			suite.Require().Equal(types.PortID, channel.Counterparty.PortId)
+		}
+		suite.Require().Equal(channels[0].ChannelId, channels[1].Counterparty.ChannelId)
+		suite.Require().Equal(channels[1].ChannelId, channels[0].Counterparty.ChannelId)
+
+		// the tokens are received back by this chain
+		var msg types.MsgTransfer
+		suite.Require().NoError(types.ModuleCdc.Unmarshal(operationMsg.Msg, &msg))
+		channel, found := app.IBCKeeper.ChannelKeeper.GetChannel(ctx, msg.SourcePort, msg.SourceChannel)
+		suite.Require().True(found)
+		voucherClassID := types.ParseClassTrace(
+			types.GetClassPrefix(channel.Counterparty.PortId, channel.Counterparty.ChannelId) + msg.ClassId,
+		).IBCClassID()
+		receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+		suite.Require().NoError(err)
+		for _, tokenID := range msg.TokenIds {
+			suite.Require().Equal(types.GetEscrowAddress(msg.SourcePort, msg.SourceChannel), app.NFTKeeper.GetOwner(ctx, msg.ClassId, tokenID))
+			suite.Require().Equal(receiver, app.NFTKeeper.GetOwner(ctx, voucherClassID, tokenID))
+		}
+
+		// the packets are acknowledged
+		suite.Require().Empty(app.IBCKeeper.ChannelKeeper.GetAllPacketCommitmentsAtChannel(ctx, msg.SourcePort, msg.SourceChannel))
+	}
+}
+
+func (suite *SimTestSuite) TestSimulateMsgTransfer() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expOK    bool
+	}{
+		{"success", func() {}, true},
+		{"send disabled", func() {
+			app := suite.chainA.App.(*simapp.SimApp)
+			err := app.NFTTransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true))
+			suite.Require().NoError(err)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.PortID = types.PortID
+			path.EndpointB.ChannelConfig.PortID = types.PortID
+			path.EndpointA.ChannelConfig.Version = types.Version
+			path.EndpointB.ChannelConfig.Version = types.Version
+
+			tc.malleate()
+			suite.coordinator.Setup(path)
+
+			app := suite.chainA.App.(*simapp.SimApp)
+			ctx := suite.beginBlock()
+			op := simulation.SimulateMsgTransfer(app.TxConfig(), app.NFTTransferKeeper, app.AccountKeeper, app.BankKeeper)
+			operationMsg, futureOperations, err := op(rand.New(rand.NewSource(1)), app.BaseApp, ctx, suite.simAccounts(), suite.chainA.ChainID)
+			suite.Require().NoError(err)
+			suite.Require().Nil(futureOperations)
+			suite.Require().Equal(tc.expOK, operationMsg.OK, operationMsg.Comment)
+
+			escrowedTokens := app.NFTTransferKeeper.GetAllEscrowedTokens(ctx)
+			if !tc.expOK {
+				suite.Require().Empty(escrowedTokens)
+				return
+			}
+
+			var msg types.MsgTransfer
+			suite.Require().NoError(types.ModuleCdc.Unmarshal(operationMsg.Msg, &msg))
+			suite.Require().Equal(path.EndpointA.ChannelID, msg.SourceChannel)
+			suite.Require().Len(escrowedTokens, len(msg.TokenIds))
+			for _, tokenID := range msg.TokenIds {
+				suite.Require().Equal(
+					types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID),
+					app.NFTKeeper.GetOwner(ctx, msg.ClassId, tokenID),
+				)
+			}
+		})
+	}
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
@@ -0,0 +1,48 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/bianjieai/nft-transfer/keeper"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgUpdateParams = "op_weight_msg_update_params" //nolint:gosec
+
+	DefaultWeightMsgUpdateParams = 100
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams randomly enabling or disabling
+// sends and receives. The other parameters are kept as currently set.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		// use the default gov module account address as authority
+		var authority sdk.AccAddress = address.Module("gov")
+
+		params := k.GetParams(ctx)
+		params.SendEnabled = RadomEnabled(r)
+		params.ReceiveEnabled = RadomEnabled(r)
+		return &types.MsgUpdateParams{
+			Authority: authority.String(),
+			Params:    params,
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package simulation_test
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/bianjieai/nft-transfer/simulation"
+	"github.com/bianjieai/nft-transfer/testing/simapp"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+func (suite *SimTestSuite) TestSimulateMsgUpdateParams() {
+	suite.SetupTest() // reset
+
+	app := suite.chainA.App.(*simapp.SimApp)
+	ctx := suite.chainA.GetContext()
+
+	params := types.DefaultParams()
+	params.ChannelParams = []types.ChannelParams{types.NewChannelParams(types.PortID, "channel-0", false, true)}
+	params.SendDeniedClasses = []string{"cryptoCat"}
+	params.Guardian = suite.chainA.SenderAccount.GetAddress().String()
+	suite.Require().NoError(app.NFTTransferKeeper.SetParams(ctx, params))
+
+	weightedProposalMsgs := simulation.ProposalMsgs(app.NFTTransferKeeper)
+	suite.Require().Len(weightedProposalMsgs, 1)
+	suite.Require().Equal(simulation.OpWeightMsgUpdateParams, weightedProposalMsgs[0].AppParamsKey())
+	suite.Require().Equal(simulation.DefaultWeightMsgUpdateParams, weightedProposalMsgs[0].DefaultWeight())
+
+	msg, ok := weightedProposalMsgs[0].MsgSimulatorFn()(rand.New(rand.NewSource(1)), ctx, suite.simAccounts()).(*types.MsgUpdateParams)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.AccAddress(address.Module("gov")).String(), msg.Authority)
+
+	// only sends and receives are randomized, the other parameters are kept
+	params.SendEnabled = msg.Params.SendEnabled
+	params.ReceiveEnabled = msg.Params.ReceiveEnabled
+	suite.Require().Equal(params, msg.Params)
+}
@@ -59,6 +59,26 @@ func SetupTestingApp() (TestingApp, map[string]json.RawMessage) {
 	return app, app.DefaultGenesis()
 }
 
+// SetupTestingAppWithNFTKeeper returns the initializer of a SimApp whose
+// nft-transfer keeper uses the NFTKeeper created by the given factory.
+func SetupTestingAppWithNFTKeeper(factory simapp.NFTKeeperFactory) func() (TestingApp, map[string]json.RawMessage) {
+	return func() (TestingApp, map[string]json.RawMessage) {
+		db := dbm.NewMemDB()
+		appOpts := simtestutil.AppOptionsMap{simapp.FlagNFTKeeperFactory: factory}
+		app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, appOpts)
+		return app, app.DefaultGenesis()
+	}
+}
+
+// SetupTestingAppWithDepinject returns a SimApp whose nft-transfer module is
+// wired through depinject.
+func SetupTestingAppWithDepinject() (TestingApp, map[string]json.RawMessage) {
+	db := dbm.NewMemDB()
+	appOpts := simtestutil.AppOptionsMap{simapp.FlagDepinject: true}
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, appOpts)
+	return app, app.DefaultGenesis()
+}
+
 // SetupWithGenesisValSet initializes a new SimApp with a validator set and genesis accounts
 // that also act as delegators. For simplicity, each validator is bonded with a delegation
 // of one consensus engine unit (10^6) in the default token of the simapp from first genesis
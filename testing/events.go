@@ -120,6 +120,27 @@ func ParsePacketFromEvents(events []abci.Event) (channeltypes.Packet, error) {
 	return channeltypes.Packet{}, fmt.Errorf("acknowledgement event attribute not found")
 }
 
+// ParsePacketsFromEvents parses events emitted from a MsgBatchTransfer and returns
+// all the packets sent, in the order they were sent.
+func ParsePacketsFromEvents(events []abci.Event) ([]channeltypes.Packet, error) {
+	var packets []channeltypes.Packet
+	for _, ev := range events {
+		if ev.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+
+		packet, err := ParsePacketFromEvents([]abci.Event{ev})
+		if err != nil {
+			return nil, err
+		}
+		packets = append(packets, packet)
+	}
+	if len(packets) == 0 {
+		return nil, fmt.Errorf("send packet event not found")
+	}
+	return packets, nil
+}
+
 // ParseAckFromEvents parses events emitted from a MsgRecvPacket and returns the
 // acknowledgement.
 func ParseAckFromEvents(events []abci.Event) ([]byte, error) {
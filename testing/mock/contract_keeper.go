@@ -0,0 +1,111 @@
+package mock
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	callbacktypes "github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ callbacktypes.ContractKeeper = (*ContractKeeper)(nil)
+
+const (
+	// SuccessContract is a contract address whose callbacks return nil
+	SuccessContract = "success"
+	// ErrorContract is a contract address whose callbacks return an error
+	ErrorContract = "errors"
+	// PanicContract is a contract address whose callbacks panic
+	PanicContract = "panics"
+)
+
+// ContractKeeper is a mock contract keeper used for testing the ibc-callbacks
+// middleware with the nft-transfer application. It records the number of
+// callbacks executed per callback type and the packet data passed to them.
+type ContractKeeper struct {
+	Counters map[callbacktypes.CallbackType]int
+	Packets  map[callbacktypes.CallbackType][]byte
+}
+
+// NewContractKeeper creates a new mock ContractKeeper.
+func NewContractKeeper() *ContractKeeper {
+	return &ContractKeeper{
+		Counters: make(map[callbacktypes.CallbackType]int),
+		Packets:  make(map[callbacktypes.CallbackType][]byte),
+	}
+}
+
+// Reset clears the recorded callbacks.
+func (k *ContractKeeper) Reset() {
+	k.Counters = make(map[callbacktypes.CallbackType]int)
+	k.Packets = make(map[callbacktypes.CallbackType][]byte)
+}
+
+// IBCSendPacketCallback implements the ContractKeeper interface.
+func (k *ContractKeeper) IBCSendPacketCallback(
+	cachedCtx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	packetData []byte,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return k.processMockCallback(callbacktypes.CallbackTypeSendPacket, packetData, contractAddress)
+}
+
+// IBCOnAcknowledgementPacketCallback implements the ContractKeeper interface.
+func (k *ContractKeeper) IBCOnAcknowledgementPacketCallback(
+	cachedCtx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return k.processMockCallback(callbacktypes.CallbackTypeAcknowledgementPacket, packet.GetData(), contractAddress)
+}
+
+// IBCOnTimeoutPacketCallback implements the ContractKeeper interface.
+func (k *ContractKeeper) IBCOnTimeoutPacketCallback(
+	cachedCtx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return k.processMockCallback(callbacktypes.CallbackTypeTimeoutPacket, packet.GetData(), contractAddress)
+}
+
+// IBCReceivePacketCallback implements the ContractKeeper interface.
+func (k *ContractKeeper) IBCReceivePacketCallback(
+	cachedCtx sdk.Context,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+	contractAddress string,
+) error {
+	return k.processMockCallback(callbacktypes.CallbackTypeReceivePacket, packet.GetData(), contractAddress)
+}
+
+// processMockCallback records the callback and behaves according to the contract address.
+func (k *ContractKeeper) processMockCallback(
+	callbackType callbacktypes.CallbackType,
+	packetData []byte,
+	contractAddress string,
+) error {
+	k.Counters[callbackType]++
+	k.Packets[callbackType] = packetData
+
+	switch contractAddress {
+	case ErrorContract:
+		return fmt.Errorf("mock %s callback failed", callbackType)
+	case PanicContract:
+		panic(fmt.Sprintf("mock %s callback panicked", callbackType))
+	default:
+		return nil
+	}
+}
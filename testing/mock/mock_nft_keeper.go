@@ -10,6 +10,8 @@ import (
 	nfttransfer "github.com/bianjieai/nft-transfer/types"
 )
 
+var _ nfttransfer.NFTIterator = MockNFTKeeper{}
+
 type (
 	MockNFTKeeper struct {
 		nk  nftkeeper.Keeper
@@ -153,3 +155,23 @@ func (wc WrappedNFT) GetClassID() string {
 func (wc WrappedNFT) GetURI() string {
 	return wc.Uri
 }
+
+// IterateClasses implements the NFTIterator interface.
+func (w MockNFTKeeper) IterateClasses(ctx sdk.Context, cb func(class nfttransfer.Class) (stop bool)) {
+	for _, class := range w.nk.GetClasses(ctx) {
+		if cb(WrappedClass{*class, w}) {
+			return
+		}
+	}
+}
+
+// IterateNFTsOfOwner implements the NFTIterator interface.
+func (w MockNFTKeeper) IterateNFTsOfOwner(ctx sdk.Context, owner sdk.AccAddress, cb func(nft nfttransfer.NFT) (stop bool)) {
+	for _, class := range w.nk.GetClasses(ctx) {
+		for _, token := range w.nk.GetNFTsOfClassByOwner(ctx, class.Id, owner) {
+			if cb(WrappedNFT{token, w}) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package mock
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nfttransfer "github.com/bianjieai/nft-transfer/types"
+)
+
+var _ nfttransfer.TransferHooks = (*TransferHooks)(nil)
+
+const (
+	// HookBeforeSendTransfer is the name of the BeforeSendTransfer hook
+	HookBeforeSendTransfer = "BeforeSendTransfer"
+	// HookAfterReceive is the name of the AfterReceive hook
+	HookAfterReceive = "AfterReceive"
+	// HookAfterAcknowledgementSuccess is the name of the AfterAcknowledgementSuccess hook
+	HookAfterAcknowledgementSuccess = "AfterAcknowledgementSuccess"
+	// HookAfterRefund is the name of the AfterRefund hook
+	HookAfterRefund = "AfterRefund"
+)
+
+// TransferHooks is a mock of the nft-transfer hooks used for testing. It records
+// the transfer info passed to each hook, consumes the gas set for the hook and
+// panics with the value or returns the error set for the hook.
+type TransferHooks struct {
+	Calls  map[string][]nfttransfer.TransferInfo
+	Errors map[string]error
+	Panics map[string]any
+	Gas    map[string]uint64
+}
+
+// NewTransferHooks creates a new mock TransferHooks.
+func NewTransferHooks() *TransferHooks {
+	return &TransferHooks{
+		Calls:  make(map[string][]nfttransfer.TransferInfo),
+		Errors: make(map[string]error),
+		Panics: make(map[string]any),
+		Gas:    make(map[string]uint64),
+	}
+}
+
+// BeforeSendTransfer implements the TransferHooks interface.
+func (h *TransferHooks) BeforeSendTransfer(ctx sdk.Context, info nfttransfer.TransferInfo) error {
+	return h.call(ctx, HookBeforeSendTransfer, info)
+}
+
+// AfterReceive implements the TransferHooks interface.
+func (h *TransferHooks) AfterReceive(ctx sdk.Context, info nfttransfer.TransferInfo) error {
+	return h.call(ctx, HookAfterReceive, info)
+}
+
+// AfterAcknowledgementSuccess implements the TransferHooks interface.
+func (h *TransferHooks) AfterAcknowledgementSuccess(ctx sdk.Context, info nfttransfer.TransferInfo) error {
+	return h.call(ctx, HookAfterAcknowledgementSuccess, info)
+}
+
+// AfterRefund implements the TransferHooks interface.
+func (h *TransferHooks) AfterRefund(ctx sdk.Context, info nfttransfer.TransferInfo) error {
+	return h.call(ctx, HookAfterRefund, info)
+}
+
+// call records the hook call unless the hook fails.
+func (h *TransferHooks) call(ctx sdk.Context, hook string, info nfttransfer.TransferInfo) error {
+	if gas := h.Gas[hook]; gas != 0 {
+		ctx.GasMeter().ConsumeGas(gas, hook)
+	}
+	if value, ok := h.Panics[hook]; ok {
+		panic(value)
+	}
+	if err := h.Errors[hook]; err != nil {
+		return err
+	}
+	h.Calls[hook] = append(h.Calls[hook], info)
+	return nil
+}
@@ -0,0 +1,313 @@
+package ibctesting
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/bianjieai/nft-transfer/testing/simapp"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+// NFTKeeperTestSuite is a reusable test suite checking that an NFTKeeper
+// implementation behaves as expected by the nft-transfer module. Chains
+// implementing the NFTKeeper against their own NFT module run it with the
+// initializer of their app, whose nft-transfer keeper uses the implementation,
+// and the accessor of the implementation:
+//
+//	func TestNFTKeeper(t *testing.T) {
+//		suite.Run(t, ibctesting.NewNFTKeeperTestSuite(setupMyApp, func(app ibctesting.TestingApp) types.NFTKeeper {
+//			return app.(*myapp.App).NFTTransferKeeper.GetNFTKeeper()
+//		}))
+//	}
+//
+// The SimApp is run with another NFTKeeper implementation by initializing it
+// with SetupTestingAppWithNFTKeeper and accessing it with SimAppNFTKeeper.
+//
+// The suite checks the class, mint, transfer, burn and owner semantics of the
+// NFTKeeper of a single chain, then sends, receives and returns tokens between
+// two chains using the NFTKeeper and refunds them on error acknowledgements and
+// timeouts. The ICS-721 class and token data given to the NFTKeeper must be
+// returned unchanged, and empty data must leave the existing data unchanged.
+type NFTKeeperTestSuite struct {
+	suite.Suite
+
+	// the ICS-721 data of the class and of the tokens minted
+	ClassData string
+	TokenData string
+
+	appInit     func() (TestingApp, map[string]json.RawMessage)
+	getKeeper   func(app TestingApp) types.NFTKeeper
+	coordinator *Coordinator
+	chainA      *TestChain
+	chainB      *TestChain
+	path        *Path
+}
+
+// invalidReceiver is a receiver rejected by the receiving chain with an error
+// acknowledgement.
+const invalidReceiver = "invalid-receiver"
+
+// NewNFTKeeperTestSuite creates the NFTKeeper test suite of the chains created by
+// the given app initializer, whose NFTKeeper under test is returned by getKeeper.
+func NewNFTKeeperTestSuite(
+	appInit func() (TestingApp, map[string]json.RawMessage),
+	getKeeper func(app TestingApp) types.NFTKeeper,
+) *NFTKeeperTestSuite {
+	return &NFTKeeperTestSuite{
+		ClassData: "{\"name\":{\"value\":\"cryptoCat\"}}",
+		TokenData: "{\"name\":{\"value\":\"kitty\"}}",
+		appInit:   appInit,
+		getKeeper: getKeeper,
+	}
+}
+
+// SimAppNFTKeeper returns the NFTKeeper used by the nft-transfer keeper of the
+// given SimApp.
+func SimAppNFTKeeper(app TestingApp) types.NFTKeeper {
+	return app.(*simapp.SimApp).NFTTransferKeeper.GetNFTKeeper()
+}
+
+// SetupTest creates two chains using the NFTKeeper under test connected by an
+// nft-transfer channel.
+func (suite *NFTKeeperTestSuite) SetupTest() {
+	appInit := DefaultTestingAppInit
+	DefaultTestingAppInit = suite.appInit
+	defer func() { DefaultTestingAppInit = appInit }()
+
+	suite.coordinator = NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(GetChainID(2))
+
+	suite.path = NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = types.PortID
+	suite.path.EndpointB.ChannelConfig.PortID = types.PortID
+	suite.path.EndpointA.ChannelConfig.Version = types.Version
+	suite.path.EndpointB.ChannelConfig.Version = types.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+// TestClass checks the creation and update of a class.
+func (suite *NFTKeeperTestSuite) TestClass() {
+	ctx, nftKeeper := suite.chainA.GetContext(), suite.nftKeeper(suite.chainA)
+
+	suite.Require().False(nftKeeper.HasClass(ctx, "cryptoCat"))
+	_, found := nftKeeper.GetClass(ctx, "cryptoCat")
+	suite.Require().False(found)
+
+	suite.Require().NoError(nftKeeper.CreateOrUpdateClass(ctx, "cryptoCat", "uri", suite.ClassData))
+	suite.Require().True(nftKeeper.HasClass(ctx, "cryptoCat"))
+	suite.assertClass(suite.chainA, "cryptoCat", "uri", suite.ClassData)
+
+	// empty class data leaves the class unchanged
+	suite.Require().NoError(nftKeeper.CreateOrUpdateClass(ctx, "cryptoCat", "uri", ""))
+	suite.assertClass(suite.chainA, "cryptoCat", "uri", suite.ClassData)
+
+	suite.Require().NoError(nftKeeper.CreateOrUpdateClass(ctx, "cryptoCat", "new-uri", suite.TokenData))
+	suite.assertClass(suite.chainA, "cryptoCat", "new-uri", suite.TokenData)
+}
+
+// TestMintAndBurn checks the minting and burning of tokens and their owner.
+func (suite *NFTKeeperTestSuite) TestMintAndBurn() {
+	ctx, nftKeeper := suite.chainA.GetContext(), suite.nftKeeper(suite.chainA)
+	owner := suite.chainA.SenderAccount.GetAddress()
+	suite.mintNFTs("kitty1")
+
+	suite.Require().Equal(owner, nftKeeper.GetOwner(ctx, "cryptoCat", "kitty1"))
+	suite.assertNFT(suite.chainA, "cryptoCat", "kitty1", "uri", suite.TokenData)
+	suite.Require().Error(nftKeeper.Mint(ctx, "cryptoCat", "kitty1", "uri", suite.TokenData, owner), "token minted twice")
+
+	suite.Require().NoError(nftKeeper.Mint(ctx, "cryptoCat", "kitty2", "", "", owner))
+	suite.assertNFT(suite.chainA, "cryptoCat", "kitty2", "", "")
+
+	suite.Require().NoError(nftKeeper.Burn(ctx, "cryptoCat", "kitty1"))
+	_, found := nftKeeper.GetNFT(ctx, "cryptoCat", "kitty1")
+	suite.Require().False(found)
+	suite.Require().Empty(nftKeeper.GetOwner(ctx, "cryptoCat", "kitty1"))
+	suite.Require().Error(nftKeeper.Burn(ctx, "cryptoCat", "kitty1"), "token burnt twice")
+}
+
+// TestTransfer checks that a transfer with empty token data leaves the token
+// data unchanged while other token data overwrites it.
+func (suite *NFTKeeperTestSuite) TestTransfer() {
+	ctx, nftKeeper := suite.chainA.GetContext(), suite.nftKeeper(suite.chainA)
+	owner := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	suite.mintNFTs("kitty1")
+
+	suite.Require().NoError(nftKeeper.Transfer(ctx, "cryptoCat", "kitty1", "", receiver))
+	suite.Require().Equal(receiver, nftKeeper.GetOwner(ctx, "cryptoCat", "kitty1"))
+	suite.assertNFT(suite.chainA, "cryptoCat", "kitty1", "uri", suite.TokenData)
+
+	suite.Require().NoError(nftKeeper.Transfer(ctx, "cryptoCat", "kitty1", suite.ClassData, owner))
+	suite.Require().Equal(owner, nftKeeper.GetOwner(ctx, "cryptoCat", "kitty1"))
+	suite.assertNFT(suite.chainA, "cryptoCat", "kitty1", "uri", suite.ClassData)
+
+	suite.Require().Error(nftKeeper.Transfer(ctx, "cryptoCat", "kitty2", "", owner), "token not found")
+}
+
+// TestSendReceiveReturn checks that tokens sent are escrowed, that vouchers are
+// minted with the class and token data on the receiving chain, and that the
+// vouchers returned are burnt and the tokens unescrowed unchanged.
+func (suite *NFTKeeperTestSuite) TestSendReceiveReturn() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	voucherClassID := suite.voucherClassID()
+	suite.mintNFTs("kitty1", "kitty2")
+
+	packet := suite.sendTransfer(suite.path.EndpointA, "cryptoCat", sender, receiver.String(), false)
+	escrowAddress := types.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	suite.assertOwner(suite.chainA, "cryptoCat", escrowAddress)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	suite.assertOwner(suite.chainA, "cryptoCat", escrowAddress)
+
+	suite.assertClass(suite.chainB, voucherClassID, "uri", suite.ClassData)
+	suite.assertOwner(suite.chainB, voucherClassID, receiver)
+	suite.assertNFT(suite.chainB, voucherClassID, "kitty1", "uri", suite.TokenData)
+
+	packet = suite.sendTransfer(suite.path.EndpointB, voucherClassID, receiver, sender.String(), false)
+	for _, tokenID := range []string{"kitty1", "kitty2"} {
+		_, found := suite.nftKeeper(suite.chainB).GetNFT(suite.chainB.GetContext(), voucherClassID, tokenID)
+		suite.Require().False(found, "voucher not burnt")
+	}
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	suite.assertOwner(suite.chainA, "cryptoCat", sender)
+	suite.assertNFT(suite.chainA, "cryptoCat", "kitty1", "uri", suite.TokenData)
+	suite.assertClass(suite.chainA, "cryptoCat", "uri", suite.ClassData)
+}
+
+// TestAcknowledgementErrorRefund checks that tokens are unescrowed unchanged to
+// the sender and that vouchers are minted again with their data on error
+// acknowledgements.
+func (suite *NFTKeeperTestSuite) TestAcknowledgementErrorRefund() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	voucherClassID := suite.voucherClassID()
+	suite.mintNFTs("kitty1", "kitty2")
+
+	// the receiving chain writes an error acknowledgement for an invalid receiver
+	packet := suite.sendTransfer(suite.path.EndpointA, "cryptoCat", sender, invalidReceiver, false)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	suite.assertOwner(suite.chainA, "cryptoCat", sender)
+	suite.assertNFT(suite.chainA, "cryptoCat", "kitty1", "uri", suite.TokenData)
+	suite.Require().False(suite.nftKeeper(suite.chainB).HasClass(suite.chainB.GetContext(), voucherClassID))
+
+	// the vouchers burnt when returned are minted again
+	packet = suite.sendTransfer(suite.path.EndpointA, "cryptoCat", sender, receiver.String(), false)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	packet = suite.sendTransfer(suite.path.EndpointB, voucherClassID, receiver, invalidReceiver, false)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	suite.assertOwner(suite.chainB, voucherClassID, receiver)
+	suite.assertNFT(suite.chainB, voucherClassID, "kitty1", "uri", suite.TokenData)
+	suite.assertClass(suite.chainB, voucherClassID, "uri", suite.ClassData)
+}
+
+// TestTimeoutRefund checks that tokens are unescrowed unchanged to the sender
+// and that vouchers are minted again with their data on timeouts.
+func (suite *NFTKeeperTestSuite) TestTimeoutRefund() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	voucherClassID := suite.voucherClassID()
+	suite.mintNFTs("kitty1", "kitty2")
+
+	packet := suite.sendTransfer(suite.path.EndpointA, "cryptoCat", sender, receiver.String(), true)
+	suite.timeoutPacket(suite.path.EndpointA, packet)
+
+	suite.assertOwner(suite.chainA, "cryptoCat", sender)
+	suite.assertNFT(suite.chainA, "cryptoCat", "kitty1", "uri", suite.TokenData)
+
+	packet = suite.sendTransfer(suite.path.EndpointA, "cryptoCat", sender, receiver.String(), false)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	packet = suite.sendTransfer(suite.path.EndpointB, voucherClassID, receiver, sender.String(), true)
+	suite.timeoutPacket(suite.path.EndpointB, packet)
+
+	suite.assertOwner(suite.chainB, voucherClassID, receiver)
+	suite.assertNFT(suite.chainB, voucherClassID, "kitty1", "uri", suite.TokenData)
+}
+
+// nftKeeper returns the NFTKeeper under test of the chain.
+func (suite *NFTKeeperTestSuite) nftKeeper(chain *TestChain) types.NFTKeeper {
+	return suite.getKeeper(chain.App)
+}
+
+// mintNFTs mints the given tokens of the cryptoCat class with the token data to
+// the sender account of chainA.
+func (suite *NFTKeeperTestSuite) mintNFTs(tokenIDs ...string) {
+	ctx, nftKeeper := suite.chainA.GetContext(), suite.nftKeeper(suite.chainA)
+	suite.Require().NoError(nftKeeper.CreateOrUpdateClass(ctx, "cryptoCat", "uri", suite.ClassData))
+	for _, tokenID := range tokenIDs {
+		suite.Require().NoError(nftKeeper.Mint(ctx, "cryptoCat", tokenID, "uri", suite.TokenData, suite.chainA.SenderAccount.GetAddress()))
+	}
+}
+
+// voucherClassID returns the class of the vouchers of the cryptoCat class
+// on chainB.
+func (suite *NFTKeeperTestSuite) voucherClassID() string {
+	prefix := types.GetClassPrefix(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+	return types.ParseClassTrace(prefix + "cryptoCat").IBCClassID()
+}
+
+// sendTransfer sends the kitty1 and kitty2 tokens of the class from the chain
+// of the endpoint and returns the packet sent. The packet times out after a
+// second if timeout is true.
+func (suite *NFTKeeperTestSuite) sendTransfer(endpoint *Endpoint, classID string, sender sdk.AccAddress, receiver string, timeout bool) channeltypes.Packet {
+	timeoutHeight, timeoutTimestamp := endpoint.Counterparty.Chain.GetTimeoutHeight(), uint64(0)
+	if timeout {
+		timeoutHeight = clienttypes.ZeroHeight()
+		timeoutTimestamp = uint64(endpoint.Counterparty.Chain.GetContext().BlockTime().Add(time.Second).UnixNano())
+	}
+
+	msg := types.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		classID, []string{"kitty1", "kitty2"},
+		sender.String(), receiver,
+		timeoutHeight, timeoutTimestamp, "",
+	)
+	res, err := endpoint.Chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return packet
+}
+
+// timeoutPacket times out the packet sent from the chain of the endpoint.
+func (suite *NFTKeeperTestSuite) timeoutPacket(endpoint *Endpoint, packet channeltypes.Packet) {
+	suite.coordinator.IncrementTimeBy(time.Minute)
+	suite.coordinator.CommitBlock(endpoint.Counterparty.Chain)
+	suite.Require().NoError(endpoint.UpdateClient())
+	suite.Require().NoError(endpoint.TimeoutPacket(packet))
+}
+
+func (suite *NFTKeeperTestSuite) assertClass(chain *TestChain, classID, classURI, classData string) {
+	class, found := suite.nftKeeper(chain).GetClass(chain.GetContext(), classID)
+	suite.Require().True(found, "class %s not found", classID)
+	suite.Require().Equal(classID, class.GetID())
+	suite.Require().Equal(classURI, class.GetURI())
+	suite.Require().Equal(classData, class.GetData())
+}
+
+func (suite *NFTKeeperTestSuite) assertNFT(chain *TestChain, classID, tokenID, tokenURI, tokenData string) {
+	token, found := suite.nftKeeper(chain).GetNFT(chain.GetContext(), classID, tokenID)
+	suite.Require().True(found, "token %s/%s not found", classID, tokenID)
+	suite.Require().Equal(classID, token.GetClassID())
+	suite.Require().Equal(tokenID, token.GetID())
+	suite.Require().Equal(tokenURI, token.GetURI())
+	suite.Require().Equal(tokenData, token.GetData())
+}
+
+// assertOwner asserts that the kitty1 and kitty2 tokens of the class are owned
+// by the given owner.
+func (suite *NFTKeeperTestSuite) assertOwner(chain *TestChain, classID string, owner sdk.AccAddress) {
+	for _, tokenID := range []string{"kitty1", "kitty2"} {
+		suite.Require().Equal(owner, suite.nftKeeper(chain).GetOwner(chain.GetContext(), classID, tokenID), tokenID)
+	}
+}
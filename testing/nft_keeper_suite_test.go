@@ -0,0 +1,19 @@
+package ibctesting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	nftadapter "github.com/bianjieai/nft-transfer/adapter"
+	ibctesting "github.com/bianjieai/nft-transfer/testing"
+	"github.com/bianjieai/nft-transfer/testing/simapp"
+	"github.com/bianjieai/nft-transfer/types"
+)
+
+func TestAdapterNFTKeeper(t *testing.T) {
+	appInit := ibctesting.SetupTestingAppWithNFTKeeper(func(app *simapp.SimApp) types.NFTKeeper {
+		return nftadapter.NewKeeper(app.NFTKeeper, nftadapter.NewRegistry(app.AppCodec()))
+	})
+	suite.Run(t, ibctesting.NewNFTKeeperTestSuite(appInit, ibctesting.SimAppNFTKeeper))
+}
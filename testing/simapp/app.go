@@ -135,6 +135,8 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
 	nfttransfer "github.com/bianjieai/nft-transfer"
+	nftadapter "github.com/bianjieai/nft-transfer/adapter"
+	"github.com/bianjieai/nft-transfer/forward"
 	ibcnfttransferkeeper "github.com/bianjieai/nft-transfer/keeper"
 	"github.com/bianjieai/nft-transfer/testing/mock"
 	ibcnfttransfertypes "github.com/bianjieai/nft-transfer/types"
@@ -147,6 +149,15 @@ const (
 	MockFeePort string = ibcmock.ModuleName + ibcfeetypes.ModuleName
 )
 
+// FlagNFTKeeperFactory is the app option holding the NFTKeeperFactory creating
+// the NFTKeeper of the nft-transfer keeper. The x/nft adapter is used if unset.
+const FlagNFTKeeperFactory = "nft-transfer.nft-keeper-factory"
+
+// NFTKeeperFactory creates the NFTKeeper used by the nft-transfer keeper of the
+// app, the keepers of the app created before the nft-transfer keeper, such as
+// the NFTKeeper, are set.
+type NFTKeeperFactory func(app *SimApp) ibcnfttransfertypes.NFTKeeper
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -213,6 +224,11 @@ type SimApp struct {
 	NFTTransferKeeper     ibcnfttransferkeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
 
+	// mock contract keeper used for testing the ibc-callbacks middleware
+	MockContractKeeper *mock.ContractKeeper
+	// mock nft-transfer hooks used for testing the transfer hooks
+	MockTransferHooks *mock.TransferHooks
+
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
@@ -258,8 +274,15 @@ func NewSimApp(
 	appOpts servertypes.AppOptions,
 	baseAppOptions ...func(*baseapp.BaseApp),
 ) *SimApp {
+	// The descriptors of the gogoproto registry reference the files registered
+	// after them as placeholders, so the messages of this module are resolved
+	// from the merged registry, as autocli builds its messages dynamically.
+	protoFiles, err := proto.MergedRegistry()
+	if err != nil {
+		panic(err)
+	}
 	interfaceRegistry, _ := types.NewInterfaceRegistryWithOptions(types.InterfaceRegistryOptions{
-		ProtoFiles: proto.HybridResolver,
+		ProtoFiles: protoFiles,
 		SigningOptions: signing.Options{
 			AddressCodec: address.Bech32Codec{
 				Bech32Prefix: sdk.GetConfig().GetBech32AccountAddrPrefix(),
@@ -499,19 +522,64 @@ func NewSimApp(
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	app.NFTTransferKeeper = ibcnfttransferkeeper.NewKeeper(
-		appCodec,
-		keys[ibcnfttransfertypes.StoreKey],
-		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		app.IBCKeeper.ChannelKeeper,
-		app.IBCKeeper.ChannelKeeper,
-		app.IBCKeeper.PortKeeper,
-		app.AccountKeeper,
-		mock.Wrap(appCodec, app.NFTKeeper),
-		scopedNFTTransferKeeper,
+
+	// the NFTKeeper may be replaced to test other implementations
+	var nftKeeper ibcnfttransfertypes.NFTKeeper = nftadapter.NewKeeper(app.NFTKeeper, nftadapter.NewRegistry(appCodec))
+	if factory, ok := appOpts.Get(FlagNFTKeeperFactory).(NFTKeeperFactory); ok {
+		nftKeeper = factory(app)
+	}
+	app.MockTransferHooks = mock.NewTransferHooks()
+	var (
+		nfttransferModule              module.AppModule
+		injectedNFTTransferICS4Wrapper = &lazyICS4Wrapper{}
 	)
-	nfttransferModule := nfttransfer.NewAppModule(app.NFTTransferKeeper)
-	nfttransferIBCModule := nfttransfer.NewIBCModule(app.NFTTransferKeeper)
+	if cast.ToBool(appOpts.Get(FlagDepinject)) {
+		app.NFTTransferKeeper, nfttransferModule = app.injectNFTTransferKeeper(appCodec, injectedNFTTransferICS4Wrapper, nftKeeper, scopedNFTTransferKeeper)
+	} else {
+		app.NFTTransferKeeper = ibcnfttransferkeeper.NewKeeper(
+			appCodec,
+			runtime.NewKVStoreService(keys[ibcnfttransfertypes.StoreKey]),
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			app.IBCFeeKeeper, // ICS4 Wrapper: fee IBC middleware
+			app.IBCKeeper.ChannelKeeper,
+			app.IBCKeeper.ClientKeeper,
+			app.IBCKeeper.PortKeeper,
+			app.AccountKeeper,
+			nftKeeper,
+			scopedNFTTransferKeeper,
+		)
+		app.NFTTransferKeeper.SetHooks(app.MockTransferHooks)
+		app.NFTTransferKeeper.SetCircuitBreaker(&app.CircuitKeeper)
+	}
+
+	// Create NFT Transfer Stack
+	// SendPacket, since it is originating from the application to core IBC:
+	// nfttransferKeeper.SendPacket -> callbacks.SendPacket -> fee.SendPacket -> channel.SendPacket
+
+	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
+	// channel.RecvPacket -> fee.OnRecvPacket -> callbacks.OnRecvPacket -> forward.OnRecvPacket -> nfttransfer.OnRecvPacket
+
+	// nft-transfer stack contains (from top to bottom):
+	// - IBC Fee Middleware
+	// - IBC Callbacks Middleware
+	// - Forward Middleware
+	// - NFT Transfer
+	app.MockContractKeeper = mock.NewContractKeeper()
+	maxCallbackGas := uint64(1_000_000)
+
+	var nfttransferIBCModule porttypes.IBCModule
+	nfttransferIBCModule = nfttransfer.NewIBCModule(app.NFTTransferKeeper)
+	nfttransferIBCModule = forward.NewIBCMiddleware(nfttransferIBCModule, app.IBCFeeKeeper, &app.NFTTransferKeeper)
+	nfttransferIBCModule = newUpgradableCallbacksMiddleware(nfttransferIBCModule, app.IBCFeeKeeper, app.MockContractKeeper, maxCallbackGas)
+	nfttransferICS4Wrapper := nfttransferIBCModule.(porttypes.ICS4Wrapper)
+	nfttransferIBCModule = ibcfee.NewIBCMiddleware(nfttransferIBCModule, app.IBCFeeKeeper)
+	// Since the callbacks middleware itself is an ics4wrapper, it needs to be passed to the nft-transfer keeper
+	app.NFTTransferKeeper.WithICS4Wrapper(nfttransferICS4Wrapper)
+	// and to the copy of the keeper held by the app module injected through depinject
+	injectedNFTTransferICS4Wrapper.ICS4Wrapper = nfttransferICS4Wrapper
+	if nfttransferModule == nil {
+		nfttransferModule = nfttransfer.NewAppModule(app.NFTTransferKeeper, app.AccountKeeper, app.BankKeeper)
+	}
 
 	// Mock Module Stack
 
@@ -740,7 +808,7 @@ func NewSimApp(
 
 	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
-	err := app.ModuleManager.RegisterServices(app.configurator)
+	err = app.ModuleManager.RegisterServices(app.configurator)
 	if err != nil {
 		panic(err)
 	}
@@ -805,10 +873,6 @@ func NewSimApp(
 
 	// At startup, after all modules have been registered, check that all proto
 	// annotations are correct.
-	protoFiles, err := proto.MergedRegistry()
-	if err != nil {
-		panic(err)
-	}
 	err = msgservice.ValidateProtoAnnotations(protoFiles)
 	if err != nil {
 		// Once we switch to using protoreflect-based antehandlers, we might
@@ -0,0 +1,88 @@
+package simapp
+
+import (
+	_ "embed"
+
+	"cosmossdk.io/core/appconfig"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+
+	ibcnfttransferkeeper "github.com/bianjieai/nft-transfer/keeper"
+	ibcnfttransfertypes "github.com/bianjieai/nft-transfer/types"
+)
+
+// FlagDepinject is the app option which, when true, wires the nft-transfer
+// module through depinject and the app config of app.yaml instead of creating
+// its keeper by hand.
+const FlagDepinject = "nft-transfer.depinject"
+
+// appConfigYAML is the app config of the modules wired through depinject.
+//
+//go:embed app.yaml
+var appConfigYAML []byte
+
+// lazyICS4Wrapper is the ICS4Wrapper supplied to the nft-transfer module wired
+// through depinject. The module is created before the IBC stack it sends through,
+// so the wrapper is set once the stack is built.
+type lazyICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+}
+
+// injectNFTTransferKeeper creates the nft-transfer keeper and app module from
+// app.yaml, with the keepers of the app the module depends on supplied to
+// depinject.
+func (app *SimApp) injectNFTTransferKeeper(
+	appCodec codec.Codec,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	nftKeeper ibcnfttransfertypes.NFTKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+) (ibcnfttransferkeeper.Keeper, module.AppModule) {
+	var (
+		nftTransferKeeper ibcnfttransferkeeper.Keeper
+		modules           map[string]appmodule.AppModule
+	)
+	err := depinject.Inject(
+		app.nftTransferConfig(appCodec, ics4Wrapper, nftKeeper, scopedKeeper),
+		&nftTransferKeeper,
+		&modules,
+	)
+	if err != nil {
+		panic(err)
+	}
+	return nftTransferKeeper, modules[ibcnfttransfertypes.ModuleName].(module.AppModule)
+}
+
+// nftTransferConfig returns the depinject config of the nft-transfer module. The
+// ICS4Wrapper and the scoped keeper of the module are wrapped in the types of
+// the module.
+func (app *SimApp) nftTransferConfig(
+	appCodec codec.Codec,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	nftKeeper ibcnfttransfertypes.NFTKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+) depinject.Config {
+	return depinject.Configs(
+		appconfig.LoadYAML(appConfigYAML),
+		depinject.Supply(
+			appCodec,
+			runtime.NewKVStoreService(app.GetKey(ibcnfttransfertypes.StoreKey)),
+			ibcnfttransfertypes.ModuleICS4Wrapper{ICS4Wrapper: ics4Wrapper},
+			app.IBCKeeper.ChannelKeeper,
+			app.IBCKeeper.ClientKeeper,
+			app.IBCKeeper.PortKeeper,
+			app.AccountKeeper,
+			app.BankKeeper,
+			nftKeeper,
+			ibcnfttransfertypes.ScopedKeeper{ScopedKeeper: scopedKeeper},
+			&app.CircuitKeeper,
+			app.MockTransferHooks,
+		),
+	)
+}
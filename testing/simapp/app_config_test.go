@@ -0,0 +1,60 @@
+package simapp
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+
+	ibcnfttransferkeeper "github.com/bianjieai/nft-transfer/keeper"
+)
+
+// OtherIBCApp is an IBC application wired through depinject next to the
+// nft-transfer module, depending on its own ICS4Wrapper and scoped keeper.
+type OtherIBCApp struct {
+	ICS4Wrapper  porttypes.ICS4Wrapper
+	ScopedKeeper capabilitykeeper.ScopedKeeper
+}
+
+type OtherIBCAppInputs struct {
+	depinject.In
+
+	ICS4Wrapper  porttypes.ICS4Wrapper
+	ScopedKeeper capabilitykeeper.ScopedKeeper
+}
+
+func ProvideOtherIBCApp(in OtherIBCAppInputs) OtherIBCApp {
+	return OtherIBCApp{ICS4Wrapper: in.ICS4Wrapper, ScopedKeeper: in.ScopedKeeper}
+}
+
+func TestInjectNFTTransferKeeperWithOtherIBCApp(t *testing.T) {
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+
+	var (
+		nftTransferKeeper ibcnfttransferkeeper.Keeper
+		other             OtherIBCApp
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			app.nftTransferConfig(app.AppCodec(), app.IBCFeeKeeper, app.NFTTransferKeeper.GetNFTKeeper(), app.ScopedNFTTransferKeeper),
+			depinject.Provide(ProvideOtherIBCApp),
+			// the other app sends its packets through the channel keeper supplied
+			// to the nft-transfer module and has its own scoped keeper
+			depinject.Supply(app.ScopedTransferKeeper),
+		),
+		&nftTransferKeeper,
+		&other,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, porttypes.ICS4Wrapper(app.IBCKeeper.ChannelKeeper), other.ICS4Wrapper)
+	require.Equal(t, app.ScopedTransferKeeper, other.ScopedKeeper)
+}
@@ -0,0 +1,81 @@
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibccallbacks "github.com/cosmos/ibc-go/modules/apps/callbacks"
+	ibccallbackstypes "github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+)
+
+var _ porttypes.UpgradableModule = upgradableCallbacksMiddleware{}
+
+// upgradableCallbacksMiddleware adds the channel upgrade callbacks to the IBC
+// callbacks middleware, whose pinned version predates channel upgradability. The
+// callbacks middleware keeps no channel state, so the upgrade callbacks are passed
+// straight to the underlying application.
+type upgradableCallbacksMiddleware struct {
+	ibccallbacks.IBCMiddleware
+	app porttypes.UpgradableModule
+}
+
+// newUpgradableCallbacksMiddleware wraps the callbacks middleware created on top of
+// the given application.
+func newUpgradableCallbacksMiddleware(
+	app porttypes.IBCModule,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	contractKeeper ibccallbackstypes.ContractKeeper,
+	maxCallbackGas uint64,
+) upgradableCallbacksMiddleware {
+	return upgradableCallbacksMiddleware{
+		IBCMiddleware: ibccallbacks.NewIBCMiddleware(app, ics4Wrapper, contractKeeper, maxCallbackGas),
+		app:           app.(porttypes.UpgradableModule),
+	}
+}
+
+// OnChanUpgradeInit implements the UpgradableModule interface
+func (im upgradableCallbacksMiddleware) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) (string, error) {
+	return im.app.OnChanUpgradeInit(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface
+func (im upgradableCallbacksMiddleware) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface
+func (im upgradableCallbacksMiddleware) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface
+func (im upgradableCallbacksMiddleware) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) {
+	im.app.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
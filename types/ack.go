@@ -0,0 +1,55 @@
+package types
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// PacketResultVersion is the version of the NonFungibleTokenPacketResult
+// written in the success acknowledgements.
+const PacketResultVersion uint32 = 1
+
+// LegacyPacketResult is the result of the success acknowledgements written by
+// the counterparties which do not write a NonFungibleTokenPacketResult.
+var LegacyPacketResult = []byte{byte(1)}
+
+// NewNonFungibleTokenPacketResult constructs a new NonFungibleTokenPacketResult instance
+func NewNonFungibleTokenPacketResult(classID, classTraceHash, receiver string) NonFungibleTokenPacketResult {
+	return NonFungibleTokenPacketResult{
+		Version:        PacketResultVersion,
+		ClassId:        classID,
+		ClassTraceHash: classTraceHash,
+		Receiver:       receiver,
+	}
+}
+
+// GetBytes is a helper for serialising
+func (r NonFungibleTokenPacketResult) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&r))
+}
+
+// Acknowledgement returns the success acknowledgement carrying the result.
+func (r NonFungibleTokenPacketResult) Acknowledgement() channeltypes.Acknowledgement {
+	return channeltypes.NewResultAcknowledgement(r.GetBytes())
+}
+
+// ParsePacketResult parses the result of a success acknowledgement. The legacy
+// result of the counterparties which do not write a NonFungibleTokenPacketResult
+// is accepted, in which case legacy is true and the result is empty.
+func ParsePacketResult(bz []byte) (result NonFungibleTokenPacketResult, legacy bool, err error) {
+	if bytes.Equal(bz, LegacyPacketResult) {
+		return result, true, nil
+	}
+	if err := ModuleCdc.UnmarshalJSON(bz, &result); err != nil {
+		return result, false, errorsmod.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal packet result: %s", err)
+	}
+	if result.Version != PacketResultVersion {
+		return result, false, errorsmod.Wrapf(ErrInvalidAcknowledgement, "unsupported packet result version %d", result.Version)
+	}
+	return result, false, nil
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParsePacketResult(t *testing.T) {
+	result := NewNonFungibleTokenPacketResult("ibc/943B966B2B8A53C50A198EDAA2B41D9A8B6D8B52D0F9D9D4A0C9F21B86E04F2A", "943B966B2B8A53C50A198EDAA2B41D9A8B6D8B52D0F9D9D4A0C9F21B86E04F2A", receiver)
+
+	tests := []struct {
+		name       string
+		bz         []byte
+		wantResult NonFungibleTokenPacketResult
+		wantLegacy bool
+		wantErr    error
+	}{
+		{"result", result.GetBytes(), result, false, nil},
+		{"result of returned tokens", NewNonFungibleTokenPacketResult("kitty", "", receiver).GetBytes(), NewNonFungibleTokenPacketResult("kitty", "", receiver), false, nil},
+		{"legacy result", []byte{byte(1)}, NonFungibleTokenPacketResult{}, true, nil},
+		{"invalid result", []byte{byte(2)}, NonFungibleTokenPacketResult{}, false, ErrInvalidAcknowledgement},
+		{"unknown field", []byte(`{"version":1,"class_id":"kitty","owner":"kitty"}`), NonFungibleTokenPacketResult{}, false, ErrInvalidAcknowledgement},
+		{"unsupported version", []byte(`{"version":2,"class_id":"kitty"}`), NonFungibleTokenPacketResult{}, false, ErrInvalidAcknowledgement},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotResult, gotLegacy, err := ParsePacketResult(tt.bz)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParsePacketResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("ParsePacketResult() result = %v, want %v", gotResult, tt.wantResult)
+			}
+			if gotLegacy != tt.wantLegacy {
+				t.Errorf("ParsePacketResult() legacy = %v, want %v", gotLegacy, tt.wantLegacy)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package types
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var _ authz.Authorization = (*TransferAuthorization)(nil)
+
+// NewTransferAuthorization creates a new TransferAuthorization object.
+func NewTransferAuthorization(allocations ...Allocation) *TransferAuthorization {
+	return &TransferAuthorization{
+		Allocations: allocations,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL. Only MsgTransfer is granted,
+// a MsgBatchTransfer may only be executed with a GenericAuthorization.
+func (TransferAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgTransfer{})
+}
+
+// Accept implements Authorization.Accept. The transferred tokens are removed
+// from the allowed tokens and counted against the max tokens of the allocation
+// of the source port and channel. The allocation is removed once either of them
+// is exhausted, and the authorization is deleted with its last allocation. The
+// tokens may only be refunded to the granter, which is the sender of the transfer.
+func (a TransferAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgTransfer, ok := msg.(*MsgTransfer)
+	if !ok {
+		return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	if msgTransfer.RefundAddress != "" && msgTransfer.RefundAddress != msgTransfer.Sender {
+		return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "refund address must be the granter")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for index, allocation := range a.Allocations {
+		if allocation.SourcePort != msgTransfer.SourcePort || allocation.SourceChannel != msgTransfer.SourceChannel {
+			continue
+		}
+
+		if !isAllowed(sdkCtx, msgTransfer.ClassId, allocation.ClassIds) {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "not allowed class %s for transfer", msgTransfer.ClassId)
+		}
+
+		if !isAllowed(sdkCtx, msgTransfer.Receiver, allocation.AllowedReceivers) {
+			return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "not allowed receiver address for transfer")
+		}
+
+		tokenCount := uint64(len(msgTransfer.TokenIds))
+		if allocation.MaxTokens != 0 && tokenCount > allocation.MaxTokens {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+				"requested %d tokens, more than the %d tokens left", tokenCount, allocation.MaxTokens)
+		}
+
+		if allocation.MaxTokens == 0 && len(allocation.AllowedTokens) == 0 {
+			return authz.AcceptResponse{Accept: true}, nil
+		}
+
+		allowedTokens, err := consumeAllowedTokens(sdkCtx, allocation.AllowedTokens, msgTransfer.ClassId, msgTransfer.TokenIds)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		updated := allocation
+		updated.AllowedTokens = allowedTokens
+		if allocation.MaxTokens != 0 {
+			updated.MaxTokens -= tokenCount
+		}
+
+		allocations := slices.Clone(a.Allocations)
+		exhausted := (allocation.MaxTokens != 0 && updated.MaxTokens == 0) ||
+			(len(allocation.AllowedTokens) != 0 && len(updated.AllowedTokens) == 0)
+		if !exhausted {
+			allocations[index] = updated
+			return authz.AcceptResponse{Accept: true, Updated: NewTransferAuthorization(allocations...)}, nil
+		}
+
+		allocations = slices.Delete(allocations, index, index+1)
+		if len(allocations) == 0 {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+		return authz.AcceptResponse{Accept: true, Updated: NewTransferAuthorization(allocations...)}, nil
+	}
+
+	return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrNotFound, "requested port and channel allocation does not exist")
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TransferAuthorization) ValidateBasic() error {
+	if len(a.Allocations) == 0 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "allocations cannot be empty")
+	}
+
+	foundChannels := make(map[string]bool)
+	for _, allocation := range a.Allocations {
+		if err := host.PortIdentifierValidator(allocation.SourcePort); err != nil {
+			return errorsmod.Wrap(err, "invalid source port ID")
+		}
+
+		if err := host.ChannelIdentifierValidator(allocation.SourceChannel); err != nil {
+			return errorsmod.Wrap(err, "invalid source channel ID")
+		}
+
+		channel := GetClassPrefix(allocation.SourcePort, allocation.SourceChannel)
+		if foundChannels[channel] {
+			return errorsmod.Wrapf(channeltypes.ErrInvalidChannel, "duplicate source port ID %s and channel ID %s", allocation.SourcePort, allocation.SourceChannel)
+		}
+		foundChannels[channel] = true
+
+		if err := validateAllowList("class ids", allocation.ClassIds); err != nil {
+			return err
+		}
+
+		if err := validateAllowList("allowed receivers", allocation.AllowedReceivers); err != nil {
+			return err
+		}
+
+		foundClasses := make(map[string]bool)
+		for _, tokens := range allocation.AllowedTokens {
+			if strings.TrimSpace(tokens.ClassId) == "" {
+				return errorsmod.Wrap(ErrInvalidAuthorization, "class id of allowed tokens cannot be blank")
+			}
+			if foundClasses[tokens.ClassId] {
+				return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicate class %s in allowed tokens", tokens.ClassId)
+			}
+			foundClasses[tokens.ClassId] = true
+
+			if len(allocation.ClassIds) != 0 && !slices.Contains(allocation.ClassIds, tokens.ClassId) {
+				return errorsmod.Wrapf(ErrInvalidAuthorization, "class %s of allowed tokens is not allowed", tokens.ClassId)
+			}
+			if len(tokens.TokenIds) == 0 {
+				return errorsmod.Wrapf(ErrInvalidAuthorization, "allowed tokens of class %s cannot be empty", tokens.ClassId)
+			}
+			if err := validateAllowList("allowed tokens", tokens.TokenIds); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAllowList checks that the entries of the given allow list are neither
+// blank nor duplicated.
+func validateAllowList(name string, entries []string) error {
+	found := make(map[string]bool)
+	for _, entry := range entries {
+		if strings.TrimSpace(entry) == "" {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "blank entry in %s", name)
+		}
+		if found[entry] {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicate entry in %s %s", name, entry)
+		}
+		found[entry] = true
+	}
+	return nil
+}
+
+// isAllowed returns a boolean indicating if the entry is in the allow list. Any
+// entry is allowed by an empty allow list.
+// gasCostPerIteration gas is consumed for each iteration.
+func isAllowed(ctx sdk.Context, entry string, allowList []string) bool {
+	if len(allowList) == 0 {
+		return true
+	}
+
+	gasCostPerIteration := ctx.KVGasConfig().IterNextCostFlat
+	return slices.ContainsFunc(allowList, func(allowed string) bool {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "nft transfer authorization")
+		return allowed == entry
+	})
+}
+
+// consumeAllowedTokens returns the allowed tokens left once the given tokens of
+// the class are transferred. Any token is allowed if there are no allowed tokens.
+// gasCostPerIteration gas is consumed for each transferred token.
+func consumeAllowedTokens(ctx sdk.Context, allowedTokens []AllowedTokens, classID string, tokenIDs []string) ([]AllowedTokens, error) {
+	if len(allowedTokens) == 0 {
+		return nil, nil
+	}
+
+	index := slices.IndexFunc(allowedTokens, func(tokens AllowedTokens) bool {
+		return tokens.ClassId == classID
+	})
+	if index < 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "not allowed class %s for transfer", classID)
+	}
+
+	gasCostPerIteration := ctx.KVGasConfig().IterNextCostFlat
+	tokensLeft := slices.Clone(allowedTokens[index].TokenIds)
+	for _, tokenID := range tokenIDs {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "nft transfer authorization")
+		i := slices.Index(tokensLeft, tokenID)
+		if i < 0 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "not allowed token %s of class %s for transfer", tokenID, classID)
+		}
+		tokensLeft = slices.Delete(tokensLeft, i, i+1)
+	}
+
+	allowedTokens = slices.Clone(allowedTokens)
+	if len(tokensLeft) == 0 {
+		return slices.Delete(allowedTokens, index, index+1), nil
+	}
+	allowedTokens[index] = AllowedTokens{ClassId: classID, TokenIds: tokensLeft}
+	return allowedTokens, nil
+}
@@ -0,0 +1,208 @@
+package types
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+func TestTransferAuthorization_ValidateBasic(t *testing.T) {
+	allocation := Allocation{
+		SourcePort:       PortID,
+		SourceChannel:    "channel-0",
+		ClassIds:         []string{"kitty"},
+		AllowedTokens:    []AllowedTokens{{ClassId: "kitty", TokenIds: []string{"kitty1", "kitty2"}}},
+		AllowedReceivers: []string{receiver},
+		MaxTokens:        1,
+	}
+	with := func(malleate func(allocation *Allocation)) Allocation {
+		allocation := allocation
+		malleate(&allocation)
+		return allocation
+	}
+
+	tests := []struct {
+		name          string
+		authorization *TransferAuthorization
+		wantErr       bool
+	}{
+		{"valid", NewTransferAuthorization(allocation), false},
+		{"valid without restrictions", NewTransferAuthorization(Allocation{SourcePort: PortID, SourceChannel: "channel-0"}), false},
+		{"valid with two channels", NewTransferAuthorization(allocation, with(func(a *Allocation) { a.SourceChannel = "channel-1" })), false},
+		{"empty allocations", NewTransferAuthorization(), true},
+		{"duplicate channel", NewTransferAuthorization(allocation, allocation), true},
+		{"invalid port", NewTransferAuthorization(with(func(a *Allocation) { a.SourcePort = "(port)" })), true},
+		{"invalid channel", NewTransferAuthorization(with(func(a *Allocation) { a.SourceChannel = "(channel)" })), true},
+		{"blank class", NewTransferAuthorization(with(func(a *Allocation) { a.ClassIds = []string{"kitty", " "} })), true},
+		{"duplicate class", NewTransferAuthorization(with(func(a *Allocation) { a.ClassIds = []string{"kitty", "kitty"} })), true},
+		{"duplicate receiver", NewTransferAuthorization(with(func(a *Allocation) { a.AllowedReceivers = []string{receiver, receiver} })), true},
+		{"allowed tokens of not allowed class", NewTransferAuthorization(with(func(a *Allocation) { a.ClassIds = []string{"cat"} })), true},
+		{"empty allowed tokens", NewTransferAuthorization(with(func(a *Allocation) { a.AllowedTokens = []AllowedTokens{{ClassId: "kitty"}} })), true},
+		{"duplicate allowed token", NewTransferAuthorization(with(func(a *Allocation) {
+			a.AllowedTokens = []AllowedTokens{{ClassId: "kitty", TokenIds: []string{"kitty1", "kitty1"}}}
+		})), true},
+		{"duplicate allowed tokens class", NewTransferAuthorization(with(func(a *Allocation) {
+			a.AllowedTokens = []AllowedTokens{{ClassId: "kitty", TokenIds: []string{"kitty1"}}, {ClassId: "kitty", TokenIds: []string{"kitty2"}}}
+		})), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.authorization.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("TransferAuthorization.ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTransferAuthorization_Accept(t *testing.T) {
+	ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithKVGasConfig(storetypes.KVGasConfig())
+	unlimited := Allocation{SourcePort: PortID, SourceChannel: "channel-1"}
+	allocation := Allocation{
+		SourcePort:       PortID,
+		SourceChannel:    "channel-0",
+		ClassIds:         []string{"kitty", "cat"},
+		AllowedTokens:    []AllowedTokens{{ClassId: "kitty", TokenIds: []string{"kitty1", "kitty2", "kitty3"}}},
+		AllowedReceivers: []string{receiver},
+		MaxTokens:        3,
+	}
+	with := func(malleate func(allocation *Allocation)) Allocation {
+		allocation := allocation
+		malleate(&allocation)
+		return allocation
+	}
+	transfer := func(channelID, classID string, tokenIDs ...string) *MsgTransfer {
+		return &MsgTransfer{
+			SourcePort:    PortID,
+			SourceChannel: channelID,
+			ClassId:       classID,
+			TokenIds:      tokenIDs,
+			Sender:        sender,
+			Receiver:      receiver,
+		}
+	}
+
+	withRefund := func(msg *MsgTransfer, refundAddress string) *MsgTransfer {
+		msg.RefundAddress = refundAddress
+		return msg
+	}
+
+	tests := []struct {
+		name          string
+		authorization *TransferAuthorization
+		msg           sdk.Msg
+		want          authz.AcceptResponse
+		wantErr       error
+	}{
+		{
+			"consume allowed tokens and max tokens",
+			NewTransferAuthorization(allocation),
+			transfer("channel-0", "kitty", "kitty1"),
+			authz.AcceptResponse{Accept: true, Updated: NewTransferAuthorization(with(func(a *Allocation) {
+				a.AllowedTokens = []AllowedTokens{{ClassId: "kitty", TokenIds: []string{"kitty2", "kitty3"}}}
+				a.MaxTokens = 2
+			}))},
+			nil,
+		},
+		{
+			"unlimited allocation is not updated",
+			NewTransferAuthorization(allocation, unlimited),
+			transfer("channel-1", "cat", "cat1", "cat2"),
+			authz.AcceptResponse{Accept: true},
+			nil,
+		},
+		{
+			"allocation removed once the allowed tokens are transferred",
+			NewTransferAuthorization(with(func(a *Allocation) { a.MaxTokens = 0 }), unlimited),
+			transfer("channel-0", "kitty", "kitty3", "kitty1", "kitty2"),
+			authz.AcceptResponse{Accept: true, Updated: NewTransferAuthorization(unlimited)},
+			nil,
+		},
+		{
+			"authorization deleted once the max tokens are transferred",
+			NewTransferAuthorization(with(func(a *Allocation) { a.AllowedTokens = nil; a.MaxTokens = 2 })),
+			transfer("channel-0", "cat", "cat1", "cat2"),
+			authz.AcceptResponse{Accept: true, Delete: true},
+			nil,
+		},
+		{
+			"more tokens than max tokens",
+			NewTransferAuthorization(with(func(a *Allocation) { a.MaxTokens = 1 })),
+			transfer("channel-0", "kitty", "kitty1", "kitty2"),
+			authz.AcceptResponse{},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"token not allowed",
+			NewTransferAuthorization(allocation),
+			transfer("channel-0", "kitty", "kitty4"),
+			authz.AcceptResponse{},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"class without allowed tokens",
+			NewTransferAuthorization(allocation),
+			transfer("channel-0", "cat", "cat1"),
+			authz.AcceptResponse{},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"class not allowed",
+			NewTransferAuthorization(allocation),
+			transfer("channel-0", "dog", "dog1"),
+			authz.AcceptResponse{},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"receiver not allowed",
+			NewTransferAuthorization(with(func(a *Allocation) { a.AllowedReceivers = []string{sender} })),
+			transfer("channel-0", "kitty", "kitty1"),
+			authz.AcceptResponse{},
+			sdkerrors.ErrInvalidAddress,
+		},
+		{
+			"refund to the granter",
+			NewTransferAuthorization(unlimited),
+			withRefund(transfer("channel-1", "cat", "cat1"), sender),
+			authz.AcceptResponse{Accept: true},
+			nil,
+		},
+		{
+			"refund to another address than the granter",
+			NewTransferAuthorization(unlimited),
+			withRefund(transfer("channel-1", "cat", "cat1"), receiver),
+			authz.AcceptResponse{},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"no allocation for channel",
+			NewTransferAuthorization(allocation),
+			transfer("channel-2", "kitty", "kitty1"),
+			authz.AcceptResponse{},
+			sdkerrors.ErrNotFound,
+		},
+		{
+			"not a transfer",
+			NewTransferAuthorization(allocation),
+			&MsgUpdateParams{},
+			authz.AcceptResponse{},
+			sdkerrors.ErrInvalidType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.authorization.Accept(ctx, tt.msg)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TransferAuthorization.Accept() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TransferAuthorization.Accept() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
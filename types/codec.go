@@ -10,6 +10,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // RegisterLegacyAminoCodec registers the necessary nft-transfer interfaces and concrete types
@@ -17,6 +18,14 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgTransfer{}, "cosmos-sdk/MsgTransferNFT", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "cosmos-sdk/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "cosmos-sdk/MsgSetNFTRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "cosmos-sdk/MsgRemoveNFTRateLimit", nil)
+	cdc.RegisterConcrete(&MsgMigrateEscrow{}, "cosmos-sdk/MsgMigrateNFTEscrow", nil)
+	cdc.RegisterConcrete(&MsgReleaseEscrow{}, "cosmos-sdk/MsgReleaseNFTEscrow", nil)
+	cdc.RegisterConcrete(&MsgPause{}, "cosmos-sdk/MsgPauseNFTTransfer", nil)
+	cdc.RegisterConcrete(&MsgUnpause{}, "cosmos-sdk/MsgUnpauseNFTTransfer", nil)
+	cdc.RegisterConcrete(&MsgBatchTransfer{}, "cosmos-sdk/MsgBatchTransferNFT", nil)
+	cdc.RegisterConcrete(&TransferAuthorization{}, "cosmos-sdk/NFTTransferAuthorization", nil)
 }
 
 // RegisterInterfaces register the ibc nft-transfer module interfaces to protobuf
@@ -25,6 +34,21 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgTransfer{},
 		&MsgUpdateParams{},
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgMigrateEscrow{},
+		&MsgReleaseEscrow{},
+		&MsgPause{},
+		&MsgUnpause{},
+		&MsgBatchTransfer{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&TransferAuthorization{},
+	)
+	// the undecodable ICS-721 metadata kept by the nft adapter may be stored in
+	// the Any metadata of the classes and tokens
+	registry.RegisterImplementations((*proto.Message)(nil),
+		&UnknownMetadata{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -6,14 +6,33 @@ import (
 
 // IBC transfer sentinel errors
 var (
-	ErrInvalidPacketTimeout = errorsmod.Register(ModuleName, 2, "invalid packet timeout")
-	ErrInvalidVersion       = errorsmod.Register(ModuleName, 3, "invalid ICS721 version")
-	ErrMaxTransferChannels  = errorsmod.Register(ModuleName, 4, "max nft-transfer channels")
-	ErrInvalidClassID       = errorsmod.Register(ModuleName, 5, "invalid class id")
-	ErrInvalidTokenID       = errorsmod.Register(ModuleName, 6, "invalid token id")
-	ErrInvalidPacket        = errorsmod.Register(ModuleName, 7, "invalid non-fungible token packet")
-	ErrTraceNotFound        = errorsmod.Register(ModuleName, 8, "classTrace trace not found")
-	ErrMarshal              = errorsmod.Register(ModuleName, 9, "failed to marshal token data")
-	ErrSendDisabled         = errorsmod.Register(ModuleName, 10, "non-fungible token transfers from this chain are disabled")
-	ErrReceiveDisabled      = errorsmod.Register(ModuleName, 11, "non-fungible token transfers to this chain are disabled")
+	ErrInvalidPacketTimeout   = errorsmod.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidVersion         = errorsmod.Register(ModuleName, 3, "invalid ICS721 version")
+	ErrMaxTransferChannels    = errorsmod.Register(ModuleName, 4, "max nft-transfer channels")
+	ErrInvalidClassID         = errorsmod.Register(ModuleName, 5, "invalid class id")
+	ErrInvalidTokenID         = errorsmod.Register(ModuleName, 6, "invalid token id")
+	ErrInvalidPacket          = errorsmod.Register(ModuleName, 7, "invalid non-fungible token packet")
+	ErrTraceNotFound          = errorsmod.Register(ModuleName, 8, "classTrace trace not found")
+	ErrMarshal                = errorsmod.Register(ModuleName, 9, "failed to marshal token data")
+	ErrSendDisabled           = errorsmod.Register(ModuleName, 10, "non-fungible token transfers from this chain are disabled")
+	ErrReceiveDisabled        = errorsmod.Register(ModuleName, 11, "non-fungible token transfers to this chain are disabled")
+	ErrInvalidForwardMetadata = errorsmod.Register(ModuleName, 12, "invalid forward metadata")
+	ErrForwardFailed          = errorsmod.Register(ModuleName, 13, "failed to forward non-fungible tokens")
+	ErrChannelSendDisabled    = errorsmod.Register(ModuleName, 14, "non-fungible token transfers over this channel from this chain are disabled")
+	ErrChannelReceiveDisabled = errorsmod.Register(ModuleName, 15, "non-fungible token transfers over this channel to this chain are disabled")
+	ErrClassSendDisabled      = errorsmod.Register(ModuleName, 16, "transfers of this class from this chain are disabled")
+	ErrClassReceiveDisabled   = errorsmod.Register(ModuleName, 17, "transfers of this class to this chain are disabled")
+	ErrInvalidRateLimit       = errorsmod.Register(ModuleName, 18, "invalid rate limit")
+	ErrRateLimitNotFound      = errorsmod.Register(ModuleName, 19, "rate limit not found")
+	ErrRateLimitExceeded      = errorsmod.Register(ModuleName, 20, "rate limit exceeded")
+	ErrEscrowedTokenNotFound  = errorsmod.Register(ModuleName, 21, "escrowed token not found")
+	ErrInvalidAuthorization   = errorsmod.Register(ModuleName, 22, "invalid nft-transfer authorization")
+	ErrInvalidAcknowledgement = errorsmod.Register(ModuleName, 23, "invalid nft-transfer acknowledgement")
+	ErrRefundRecordNotFound   = errorsmod.Register(ModuleName, 24, "refund record not found")
+	ErrChannelUsable          = errorsmod.Register(ModuleName, 25, "channel is still usable")
+	ErrInvalidEscrowRelease   = errorsmod.Register(ModuleName, 26, "invalid escrow release")
+	ErrPaused                 = errorsmod.Register(ModuleName, 27, "transfers are paused")
+	ErrInvalidPause           = errorsmod.Register(ModuleName, 28, "invalid pause")
+	ErrPauseNotFound          = errorsmod.Register(ModuleName, 29, "pause not found")
+	ErrPacketTooLarge         = errorsmod.Register(ModuleName, 30, "packet too large")
 )
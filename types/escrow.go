@@ -0,0 +1,119 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaxEvidenceLength is the maximum length of the evidence recorded with the
+// tokens released from escrow
+const MaxEvidenceLength = 2048
+
+// NewEscrowedToken creates a new EscrowedToken instance
+func NewEscrowedToken(portID, channelID, classID, tokenID string) EscrowedToken {
+	return EscrowedToken{
+		PortId:    portID,
+		ChannelId: channelID,
+		ClassId:   classID,
+		TokenId:   tokenID,
+	}
+}
+
+// Validate performs a basic validation of the EscrowedToken fields
+func (et EscrowedToken) Validate() error {
+	if err := host.PortIdentifierValidator(et.PortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(et.ChannelId); err != nil {
+		return err
+	}
+	if strings.TrimSpace(et.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "classId cannot be blank")
+	}
+	if strings.TrimSpace(et.TokenId) == "" {
+		return errorsmod.Wrap(ErrInvalidTokenID, "tokenId cannot be blank")
+	}
+	return nil
+}
+
+// NewEscrowedClassCount creates a new EscrowedClassCount instance
+func NewEscrowedClassCount(portID, channelID, classID string, count uint64) EscrowedClassCount {
+	return EscrowedClassCount{
+		PortId:    portID,
+		ChannelId: channelID,
+		ClassId:   classID,
+		Count:     count,
+	}
+}
+
+// NewEscrowRelease creates a new EscrowRelease instance
+func NewEscrowRelease(classID, tokenID, receiver string) EscrowRelease {
+	return EscrowRelease{
+		ClassId:  classID,
+		TokenId:  tokenID,
+		Receiver: receiver,
+	}
+}
+
+// Validate performs a basic validation of the EscrowRelease fields
+func (er EscrowRelease) Validate() error {
+	if strings.TrimSpace(er.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "classId cannot be blank")
+	}
+	if strings.TrimSpace(er.TokenId) == "" {
+		return errorsmod.Wrap(ErrInvalidTokenID, "tokenId cannot be blank")
+	}
+	if _, err := sdk.AccAddressFromBech32(er.Receiver); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %v", err)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the EscrowReleaseRecord fields
+func (r EscrowReleaseRecord) Validate() error {
+	if r.Id == 0 {
+		return errorsmod.Wrap(ErrInvalidEscrowRelease, "record id cannot be 0")
+	}
+	if r.Height < 0 {
+		return errorsmod.Wrapf(ErrInvalidEscrowRelease, "height cannot be negative: %d", r.Height)
+	}
+	return ValidateEscrowReleases(r.PortId, r.ChannelId, r.Releases, r.Evidence)
+}
+
+// ValidateEscrowReleases performs a basic validation of the tokens released
+// from the escrow account of the given port and channel and of their evidence.
+func ValidateEscrowReleases(portID, channelID string, releases []EscrowRelease, evidence string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return err
+	}
+	if len(releases) == 0 {
+		return errorsmod.Wrap(ErrInvalidEscrowRelease, "releases cannot be empty")
+	}
+	seenTokens := make(map[[2]string]bool)
+	for _, release := range releases {
+		if err := release.Validate(); err != nil {
+			return err
+		}
+		key := [2]string{release.ClassId, release.TokenId}
+		if seenTokens[key] {
+			return errorsmod.Wrapf(ErrInvalidEscrowRelease, "duplicate token %s/%s", release.ClassId, release.TokenId)
+		}
+		seenTokens[key] = true
+	}
+	if strings.TrimSpace(evidence) == "" {
+		return errorsmod.Wrap(ErrInvalidEscrowRelease, "evidence cannot be blank")
+	}
+	if len(evidence) > MaxEvidenceLength {
+		return errorsmod.Wrapf(ErrInvalidEscrowRelease, "evidence length %d exceeds the maximum %d", len(evidence), MaxEvidenceLength)
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ const (
 	EventTypeTransfer     = "ibc_nft_transfer"
 	EventTypeChannelClose = "channel_closed"
 	EventTypeClassTrace   = "class_trace"
+	EventTypeForward      = "nft_forward"
+	EventTypeHookFailed   = "transfer_hook_failed"
 
 	AttributeKeySender     = "sender"
 	AttributeKeyReceiver   = "receiver"
@@ -16,4 +18,29 @@ const (
 	AttributeKeyAckSuccess = "success"
 	AttributeKeyAckError   = "error"
 	AttributeKeyTraceHash  = "trace_hash"
+
+	AttributeKeyAckClassID   = "ack_class_id"
+	AttributeKeyAckTraceHash = "ack_trace_hash"
+	AttributeKeyAckReceiver  = "ack_receiver"
+
+	AttributeKeyForwardPort     = "forward_port"
+	AttributeKeyForwardChannel  = "forward_channel"
+	AttributeKeyForwardSequence = "forward_sequence"
+	AttributeKeyRefundChannel   = "refund_channel"
+	AttributeKeyRefundSequence  = "refund_sequence"
+
+	AttributeKeyHook      = "hook"
+	AttributeKeyPortID    = "port_id"
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeySequence  = "sequence"
+	AttributeKeyHookError = "hook_error"
 )
+
+// GetTransferDirection returns the direction of a transfer of tokens moving
+// away from or back toward their chain of origin.
+func GetTransferDirection(isAwayFromOrigin bool) TransferDirection {
+	if isAwayFromOrigin {
+		return DirectionEscrow
+	}
+	return DirectionBurn
+}
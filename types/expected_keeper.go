@@ -3,12 +3,15 @@ package types
 import (
 	context "context"
 
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 // Class defines the interface specifications of collection that can be transferred across chains
@@ -39,6 +42,15 @@ type NFTKeeper interface {
 	GetNFT(ctx sdk.Context, classID, tokenID string) (NFT, bool)
 }
 
+// NFTIterator defines the optional interface of an NFTKeeper which is able to
+// enumerate the classes and the tokens held by an account. It is required to
+// build the escrow index from the existing state on migration and by the
+// invariants checking the voucher classes and the escrow accounts.
+type NFTIterator interface {
+	IterateClasses(ctx sdk.Context, cb func(class Class) (stop bool))
+	IterateNFTsOfOwner(ctx sdk.Context, owner sdk.AccAddress, cb func(nft NFT) (stop bool))
+}
+
 // ICS4Wrapper defines the expected ICS4Wrapper for middleware
 type ICS4Wrapper interface {
 	SendPacket(
@@ -52,10 +64,37 @@ type ICS4Wrapper interface {
 	) (sequence uint64, err error)
 }
 
+// ModuleICS4Wrapper holds the ICS4Wrapper the packets of the nft-transfer module
+// are sent through, so that depinject supplies it to the module apart from the
+// ICS4Wrappers of the other IBC applications of the app. It does not implement
+// the ICS4Wrapper itself so as not to be supplied to them in turn.
+type ModuleICS4Wrapper struct {
+	ICS4Wrapper porttypes.ICS4Wrapper
+}
+
+// ScopedKeeper holds the capability keeper scoped to the nft-transfer module, so
+// that depinject supplies it to the module apart from the scoped keepers of the
+// other IBC applications of the app.
+type ScopedKeeper struct {
+	ScopedKeeper capabilitykeeper.ScopedKeeper
+}
+
 // ChannelKeeper defines the expected IBC channel keeper
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+}
+
+// CircuitBreaker defines the expected x/circuit keeper
+type CircuitBreaker interface {
+	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+}
+
+// ClientKeeper defines the expected IBC client keeper
+type ClientKeeper interface {
+	GetClientStatus(ctx sdk.Context, clientState ibcexported.ClientState, clientID string) ibcexported.Status
 }
 
 // PortKeeper defines the expected IBC port keeper
@@ -66,8 +105,14 @@ type PortKeeper interface {
 // AccountKeeper defines the contract required for account APIs.
 type AccountKeeper interface {
 	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	// Set an account in the store.
 	SetAccount(context.Context, sdk.AccountI)
 	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
 	GetModuleAddress(name string) sdk.AccAddress
 }
+
+// BankKeeper defines the expected bank keeper used by the simulation to pay the fees
+type BankKeeper interface {
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
@@ -0,0 +1,201 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+const (
+	// ForwardMemoKey is the key of the forwarding instruction in the packet memo
+	ForwardMemoKey = "forward"
+
+	// SourceCallbackMemoKey and DestinationCallbackMemoKey are the keys of the
+	// instructions of the IBC callbacks middleware in the packet memo
+	SourceCallbackMemoKey      = "src_callback"
+	DestinationCallbackMemoKey = "dest_callback"
+
+	// DefaultForwardTimeout is the default relative timeout of a forwarded packet
+	DefaultForwardTimeout = 10 * time.Minute
+)
+
+// PacketMetadata defines the memo format understood by the forward middleware.
+//
+// Example:
+//
+//	{
+//	  "forward": {
+//	    "receiver": "iaa1...",
+//	    "port": "nft-transfer",
+//	    "channel": "channel-1",
+//	    "timeout": "10m",
+//	    "next": {"forward": {...}}
+//	  }
+//	}
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata defines where the received non-fungible tokens are sent next
+type ForwardMetadata struct {
+	// the receiver on the next chain
+	Receiver string `json:"receiver"`
+	// the port on which the tokens are forwarded
+	Port string `json:"port"`
+	// the channel on which the tokens are forwarded
+	Channel string `json:"channel"`
+	// optional relative timeout of the forwarded packet, e.g. "10m"
+	Timeout string `json:"timeout,omitempty"`
+	// optional memo of the forwarded packet, which may contain the next hop
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// ParseForwardMetadata extracts the forwarding instruction from a packet memo.
+// It returns nil without error if the memo does not contain a forwarding instruction.
+func ParseForwardMetadata(memo string) (*ForwardMetadata, error) {
+	if !strings.Contains(memo, ForwardMemoKey) {
+		return nil, nil
+	}
+
+	var content map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &content); err != nil {
+		// the memo is not a json object, so it is not meant for forwarding
+		return nil, nil
+	}
+	if _, ok := content[ForwardMemoKey]; !ok {
+		return nil, nil
+	}
+
+	var metadata PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidForwardMetadata, "cannot unmarshal forward metadata: %s", err)
+	}
+	if metadata.Forward == nil {
+		return nil, errorsmod.Wrap(ErrInvalidForwardMetadata, "forward metadata cannot be empty")
+	}
+	if err := metadata.Forward.Validate(); err != nil {
+		return nil, err
+	}
+	return metadata.Forward, nil
+}
+
+// Validate performs a basic validation of the forwarding instruction
+func (m ForwardMetadata) Validate() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return errorsmod.Wrap(ErrInvalidForwardMetadata, "receiver cannot be blank")
+	}
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid port: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid channel: %s", err)
+	}
+	if _, err := m.GetTimeout(); err != nil {
+		return err
+	}
+	if _, err := m.GetNextMemo(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTimeout returns the relative timeout of the forwarded packet
+func (m ForwardMetadata) GetTimeout() (time.Duration, error) {
+	if m.Timeout == "" {
+		return DefaultForwardTimeout, nil
+	}
+	timeout, err := time.ParseDuration(m.Timeout)
+	if err != nil {
+		return 0, errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid timeout: %s", err)
+	}
+	if timeout <= 0 {
+		return 0, errorsmod.Wrap(ErrInvalidForwardMetadata, "timeout must be positive")
+	}
+	return timeout, nil
+}
+
+// GetNextMemo returns the memo of the forwarded packet. The next field may either
+// be a json object, which is passed on as is, or a json string.
+func (m ForwardMetadata) GetNextMemo() (string, error) {
+	if len(m.Next) == 0 || string(m.Next) == "null" {
+		return "", nil
+	}
+
+	var memo string
+	if err := json.Unmarshal(m.Next, &memo); err == nil {
+		return memo, nil
+	}
+
+	var next map[string]json.RawMessage
+	if err := json.Unmarshal(m.Next, &next); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidForwardMetadata, "next must be a json object or a string")
+	}
+	return string(m.Next), nil
+}
+
+// GetForwardAddress returns the intermediate address which holds the received tokens
+// until they are forwarded. The address is derived from the receiving channel and
+// the original sender so that it cannot be controlled by anyone.
+func GetForwardAddress(channelID, originalSender string) sdk.AccAddress {
+	contents := fmt.Sprintf("%s/%s", channelID, originalSender)
+	return address.Module(ModuleName, []byte(ForwardMemoKey), []byte(contents))[:20]
+}
+
+// NewInFlightPacket creates a new InFlightPacket instance
+func NewInFlightPacket(
+	forwardPort, forwardChannel string,
+	forwardSequence uint64,
+	packet channeltypes.Packet,
+) InFlightPacket {
+	return InFlightPacket{
+		ForwardPortId:          forwardPort,
+		ForwardChannelId:       forwardChannel,
+		ForwardSequence:        forwardSequence,
+		PacketSrcPortId:        packet.GetSourcePort(),
+		PacketSrcChannelId:     packet.GetSourceChannel(),
+		RefundPortId:           packet.GetDestPort(),
+		RefundChannelId:        packet.GetDestChannel(),
+		RefundSequence:         packet.GetSequence(),
+		PacketData:             packet.GetData(),
+		PacketTimeoutHeight:    packet.TimeoutHeight,
+		PacketTimeoutTimestamp: packet.GetTimeoutTimestamp(),
+	}
+}
+
+// GetPacket returns the original packet whose acknowledgement is pending
+func (p InFlightPacket) GetPacket() channeltypes.Packet {
+	return channeltypes.NewPacket(
+		p.PacketData,
+		p.RefundSequence,
+		p.PacketSrcPortId,
+		p.PacketSrcChannelId,
+		p.RefundPortId,
+		p.RefundChannelId,
+		clienttypes.NewHeight(p.PacketTimeoutHeight.RevisionNumber, p.PacketTimeoutHeight.RevisionHeight),
+		p.PacketTimeoutTimestamp,
+	)
+}
+
+// Validate performs a basic validation of the InFlightPacket fields
+func (p InFlightPacket) Validate() error {
+	if err := host.PortIdentifierValidator(p.ForwardPortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(p.ForwardChannelId); err != nil {
+		return err
+	}
+	if p.ForwardSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "forward sequence cannot be 0")
+	}
+	return p.GetPacket().ValidateBasic()
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		memo     string
+		wantNil  bool
+		wantMemo string
+		wantErr  bool
+	}{
+		{"empty memo", "", true, "", false},
+		{"plain text memo", "forward me", true, "", false},
+		{"json memo without forward", `{"wasm":{}}`, true, "", false},
+		{
+			"valid forward",
+			`{"forward":{"receiver":"receiver","port":"nft-transfer","channel":"channel-1"}}`,
+			false, "", false,
+		},
+		{
+			"valid forward with next hop",
+			`{"forward":{"receiver":"receiver","port":"nft-transfer","channel":"channel-1","next":{"forward":{"receiver":"r","port":"nft-transfer","channel":"channel-2"}}}}`,
+			false, `{"forward":{"receiver":"r","port":"nft-transfer","channel":"channel-2"}}`, false,
+		},
+		{
+			"valid forward with string next",
+			`{"forward":{"receiver":"receiver","port":"nft-transfer","channel":"channel-1","next":"memo"}}`,
+			false, "memo", false,
+		},
+		{"null forward", `{"forward":null}`, false, "", true},
+		{"blank receiver", `{"forward":{"receiver":"","port":"nft-transfer","channel":"channel-1"}}`, false, "", true},
+		{"invalid channel", `{"forward":{"receiver":"receiver","port":"nft-transfer","channel":"(channel)"}}`, false, "", true},
+		{"invalid timeout", `{"forward":{"receiver":"receiver","port":"nft-transfer","channel":"channel-1","timeout":"-1m"}}`, false, "", true},
+		{"invalid next", `{"forward":{"receiver":"receiver","port":"nft-transfer","channel":"channel-1","next":1}}`, false, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata, err := ParseForwardMetadata(tt.memo)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseForwardMetadata() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if (metadata == nil) != tt.wantNil {
+				t.Fatalf("ParseForwardMetadata() = %v, wantNil %v", metadata, tt.wantNil)
+			}
+			if metadata == nil {
+				return
+			}
+			if memo, _ := metadata.GetNextMemo(); memo != tt.wantMemo {
+				t.Errorf("GetNextMemo() = %v, want %v", memo, tt.wantMemo)
+			}
+		})
+	}
+}
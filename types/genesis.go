@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
@@ -28,5 +30,64 @@ func (gs GenesisState) Validate() error {
 	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
 		return err
 	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	for _, packet := range gs.InFlightPackets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, flow := range gs.RateLimitFlows {
+		if err := flow.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, packet := range gs.PendingSendPackets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+	}
+	seenTokens := make(map[[2]string]bool)
+	for _, token := range gs.EscrowedTokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+		key := [2]string{token.ClassId, token.TokenId}
+		if seenTokens[key] {
+			return fmt.Errorf("duplicate escrowed token %s/%s", token.ClassId, token.TokenId)
+		}
+		seenTokens[key] = true
+	}
+	for _, record := range gs.RefundRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, sendHeight := range gs.PacketSendHeights {
+		if err := sendHeight.Validate(); err != nil {
+			return err
+		}
+	}
+	seenRecords := make(map[uint64]bool)
+	for _, record := range gs.EscrowReleaseRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if seenRecords[record.Id] {
+			return fmt.Errorf("duplicate escrow release record %d", record.Id)
+		}
+		seenRecords[record.Id] = true
+	}
+	for _, pause := range gs.Pauses {
+		if err := pause.Validate(); err != nil {
+			return err
+		}
+	}
 	return gs.Traces.Validate()
 }
@@ -25,9 +25,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ibc-nft-transfer genesis state
 type GenesisState struct {
-	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	Traces Traces `protobuf:"bytes,2,rep,name=traces,proto3,castrepeated=Traces" json:"traces"`
-	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	PortId               string                `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	Traces               Traces                `protobuf:"bytes,2,rep,name=traces,proto3,castrepeated=Traces" json:"traces"`
+	Params               Params                `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	InFlightPackets      []InFlightPacket      `protobuf:"bytes,4,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets"`
+	RateLimits           []RateLimit           `protobuf:"bytes,5,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	RateLimitFlows       []RateLimitFlow       `protobuf:"bytes,6,rep,name=rate_limit_flows,json=rateLimitFlows,proto3" json:"rate_limit_flows"`
+	PendingSendPackets   []PendingSendPacket   `protobuf:"bytes,7,rep,name=pending_send_packets,json=pendingSendPackets,proto3" json:"pending_send_packets"`
+	EscrowedTokens       []EscrowedToken       `protobuf:"bytes,8,rep,name=escrowed_tokens,json=escrowedTokens,proto3" json:"escrowed_tokens"`
+	RefundRecords        []RefundRecord        `protobuf:"bytes,9,rep,name=refund_records,json=refundRecords,proto3" json:"refund_records"`
+	PacketSendHeights    []PacketSendHeight    `protobuf:"bytes,10,rep,name=packet_send_heights,json=packetSendHeights,proto3" json:"packet_send_heights"`
+	EscrowReleaseRecords []EscrowReleaseRecord `protobuf:"bytes,11,rep,name=escrow_release_records,json=escrowReleaseRecords,proto3" json:"escrow_release_records"`
+	Pauses               []Pause               `protobuf:"bytes,12,rep,name=pauses,proto3" json:"pauses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +93,69 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetInFlightPackets() []InFlightPacket {
+	if m != nil {
+		return m.InFlightPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitFlows() []RateLimitFlow {
+	if m != nil {
+		return m.RateLimitFlows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSendPackets() []PendingSendPacket {
+	if m != nil {
+		return m.PendingSendPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrowedTokens() []EscrowedToken {
+	if m != nil {
+		return m.EscrowedTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRefundRecords() []RefundRecord {
+	if m != nil {
+		return m.RefundRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPacketSendHeights() []PacketSendHeight {
+	if m != nil {
+		return m.PacketSendHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrowReleaseRecords() []EscrowReleaseRecord {
+	if m != nil {
+		return m.EscrowReleaseRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauses() []Pause {
+	if m != nil {
+		return m.Pauses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.nft_transfer.v1.GenesisState")
 }
@@ -93,25 +165,45 @@ func init() {
 }
 
 var fileDescriptor_1971f5a454018ffc = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcb, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0x4b, 0x2b,
-	0x89, 0x2f, 0x29, 0x4a, 0xcc, 0x2b, 0x4e, 0x4b, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0xc8, 0x4c, 0x4a, 0xd6,
-	0x43, 0x56, 0xaf, 0x87, 0xac, 0x5e, 0xaf, 0xcc, 0x50, 0x4a, 0x9f, 0xa0, 0x89, 0x70, 0xd5, 0x60,
-	0x23, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0x74, 0x94,
-	0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x75, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x38, 0x17, 0x7b, 0x41,
-	0x7e, 0x51, 0x49, 0x7c, 0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x1b, 0x88, 0xeb,
-	0x99, 0x22, 0x14, 0xc2, 0xc5, 0x56, 0x52, 0x94, 0x98, 0x9c, 0x5a, 0x2c, 0xc1, 0xa4, 0xc0, 0xac,
-	0xc1, 0x6d, 0xa4, 0xa3, 0x47, 0xc8, 0x8d, 0x7a, 0xce, 0x39, 0x89, 0xc5, 0xc5, 0x21, 0x20, 0x4d,
-	0x4e, 0x7c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0xba, 0x2f, 0xcf, 0x06, 0xe6, 0x16, 0x07, 0x41, 0xcd,
-	0x12, 0x72, 0xe3, 0x62, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x96, 0x60, 0x56, 0x60, 0xd4, 0xe0,
-	0x36, 0xd2, 0x20, 0x6c, 0x6a, 0x00, 0x58, 0xbd, 0x13, 0x0b, 0xc8, 0xc4, 0x20, 0xa8, 0x6e, 0x27,
-	0xc7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63,
-	0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4f, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xca, 0x4c, 0xcc, 0xcb, 0xca, 0x4c, 0x4d, 0xcc,
-	0x04, 0x05, 0x96, 0x2e, 0x3c, 0xb0, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x21, 0x62,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xd8, 0xff, 0xd7, 0xac, 0x01, 0x00, 0x00,
+	// 599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0xd4, 0x40,
+	0x14, 0xc0, 0x77, 0x05, 0x17, 0x98, 0x45, 0x90, 0x91, 0x68, 0xc3, 0x61, 0xd9, 0x78, 0x61, 0x13,
+	0xa1, 0xe5, 0x4f, 0xfc, 0x00, 0x62, 0x40, 0x49, 0x3c, 0x90, 0xc2, 0x49, 0x13, 0x9b, 0xd9, 0xf6,
+	0xb5, 0x3b, 0xd2, 0x9d, 0xa9, 0xf3, 0x66, 0xd9, 0xf0, 0x2d, 0xfc, 0x1c, 0xc6, 0x0f, 0xc2, 0x91,
+	0xa3, 0x27, 0x35, 0xf0, 0x45, 0xcc, 0x4c, 0x87, 0x65, 0xc1, 0x43, 0xeb, 0xad, 0x7d, 0x7d, 0xbf,
+	0xdf, 0x9b, 0xf7, 0xa6, 0x33, 0xc4, 0xe7, 0xfd, 0x38, 0x60, 0x45, 0x91, 0xf3, 0x98, 0x69, 0x2e,
+	0x05, 0x06, 0x22, 0xd5, 0x91, 0x56, 0x4c, 0x60, 0x0a, 0x2a, 0x38, 0xdf, 0x09, 0x32, 0x10, 0x80,
+	0x1c, 0xfd, 0x42, 0x49, 0x2d, 0x69, 0x97, 0xf7, 0x63, 0x7f, 0x3a, 0xdf, 0x9f, 0xce, 0xf7, 0xcf,
+	0x77, 0xd6, 0x82, 0x4a, 0xe3, 0x24, 0xdb, 0x2a, 0xd7, 0xaa, 0x97, 0x90, 0x4a, 0x35, 0x66, 0x2a,
+	0x71, 0xf9, 0x3b, 0x95, 0xf9, 0x8a, 0x69, 0x88, 0x72, 0x3e, 0xe4, 0xda, 0x21, 0x5b, 0x95, 0x08,
+	0x60, 0xac, 0xe4, 0xb8, 0x76, 0xba, 0x82, 0x74, 0x24, 0x6e, 0x17, 0xb4, 0x5d, 0xdd, 0x31, 0xe4,
+	0x30, 0x04, 0xad, 0x2e, 0x1c, 0xb1, 0x59, 0x49, 0x14, 0x6c, 0x84, 0xe0, 0xb2, 0x57, 0x33, 0x99,
+	0x49, 0xfb, 0x18, 0x98, 0xa7, 0x32, 0xfa, 0xf2, 0xc7, 0x3c, 0x59, 0x7c, 0x57, 0xee, 0xcd, 0x89,
+	0x66, 0x1a, 0xe8, 0x0b, 0x32, 0x57, 0x48, 0xa5, 0x23, 0x9e, 0x78, 0xcd, 0x6e, 0xb3, 0xb7, 0x10,
+	0xb6, 0xcc, 0xeb, 0x51, 0x42, 0x4f, 0x49, 0x4b, 0x2b, 0x16, 0x03, 0x7a, 0x8f, 0xba, 0x33, 0xbd,
+	0xf6, 0xee, 0xa6, 0x5f, 0xb5, 0x89, 0xfe, 0xdb, 0x9c, 0x21, 0x9e, 0x1a, 0x68, 0x7f, 0xe9, 0xf2,
+	0xd7, 0x7a, 0xe3, 0xfb, 0xef, 0xf5, 0x96, 0x7d, 0xc5, 0xd0, 0xb9, 0xe8, 0x21, 0x69, 0x15, 0x4c,
+	0xb1, 0x21, 0x7a, 0x33, 0xdd, 0x66, 0xaf, 0xbd, 0xdb, 0xab, 0xb6, 0x1e, 0xdb, 0xfc, 0xfd, 0x59,
+	0x63, 0x0c, 0x1d, 0x4d, 0xfb, 0x64, 0x85, 0x8b, 0x28, 0xcd, 0x79, 0x36, 0xd0, 0x51, 0xc1, 0xe2,
+	0x33, 0xd0, 0xe8, 0xcd, 0xda, 0x85, 0x6e, 0x57, 0x2b, 0x8f, 0xc4, 0xa1, 0x25, 0x8f, 0x2d, 0xe8,
+	0xd4, 0xcb, 0xfc, 0x5e, 0x14, 0x69, 0x48, 0xda, 0x77, 0xff, 0x04, 0x7a, 0x8f, 0xad, 0xfd, 0x55,
+	0xb5, 0x3d, 0x64, 0x1a, 0x3e, 0x18, 0xc6, 0x89, 0x89, 0xba, 0x0d, 0x20, 0x8d, 0xc8, 0xd3, 0x3b,
+	0x67, 0x94, 0xe6, 0x72, 0x8c, 0x5e, 0xcb, 0x8a, 0x83, 0xff, 0x10, 0x1f, 0xe6, 0x72, 0xec, 0xe4,
+	0x4b, 0x6a, 0x3a, 0x88, 0xf4, 0x8c, 0xac, 0x16, 0x20, 0x12, 0x2e, 0xb2, 0x08, 0x41, 0x24, 0x93,
+	0xd9, 0xcc, 0xd9, 0x22, 0x7b, 0x35, 0xc6, 0x5d, 0xd2, 0x27, 0x20, 0x92, 0x7b, 0xe3, 0xa1, 0xc5,
+	0xc3, 0x0f, 0x48, 0x3f, 0x93, 0xe5, 0xf2, 0x08, 0x40, 0x12, 0x69, 0x79, 0x06, 0x02, 0xbd, 0xf9,
+	0xba, 0xcd, 0x1c, 0x38, 0xf0, 0xd4, 0x70, 0xb7, 0xcd, 0xc0, 0x74, 0x10, 0xe9, 0x27, 0xb2, 0x54,
+	0x9e, 0x99, 0x48, 0x41, 0x2c, 0x55, 0x82, 0xde, 0x82, 0xd5, 0xfb, 0x35, 0x66, 0x65, 0xb9, 0xd0,
+	0x62, 0xce, 0xfe, 0x44, 0x4d, 0xc5, 0x90, 0x0e, 0xc8, 0xb3, 0x72, 0x38, 0xe5, 0xa0, 0x06, 0x60,
+	0xf6, 0x1e, 0x3d, 0x62, 0x2b, 0xec, 0xd6, 0xf9, 0x2f, 0x0d, 0x6c, 0xc6, 0xf1, 0xde, 0xa2, 0xae,
+	0xca, 0x4a, 0xf1, 0x20, 0x8e, 0xf4, 0x2b, 0x79, 0x5e, 0x36, 0x16, 0x29, 0xc8, 0x81, 0x21, 0x4c,
+	0xda, 0x69, 0xdb, 0x62, 0xaf, 0xeb, 0x4e, 0x2b, 0x2c, 0xf1, 0x7b, 0x5d, 0xad, 0xc2, 0xbf, 0x9f,
+	0x90, 0x1e, 0x98, 0x73, 0x36, 0x42, 0x40, 0x6f, 0xd1, 0x96, 0xd8, 0xa8, 0xd3, 0xcf, 0x08, 0xe1,
+	0xee, 0x98, 0x19, 0x78, 0xff, 0xcd, 0xe5, 0x75, 0xa7, 0x79, 0x75, 0xdd, 0x69, 0xfe, 0xb9, 0xee,
+	0x34, 0xbf, 0xdd, 0x74, 0x1a, 0x57, 0x37, 0x9d, 0xc6, 0xcf, 0x9b, 0x4e, 0xe3, 0xe3, 0x46, 0xc6,
+	0xf5, 0x60, 0xd4, 0xf7, 0x63, 0x39, 0x0c, 0xfa, 0x9c, 0x89, 0x2f, 0x1c, 0x18, 0x37, 0x17, 0xd2,
+	0xd6, 0xe4, 0x42, 0xd2, 0x17, 0x05, 0x60, 0xbf, 0x65, 0x2f, 0x9e, 0xbd, 0xbf, 0x03, 0x00, 0xfe,
+	0x52, 0x1a, 0xee, 0x34, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -134,6 +226,132 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Pauses) > 0 {
+		for iNdEx := len(m.Pauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.EscrowReleaseRecords) > 0 {
+		for iNdEx := len(m.EscrowReleaseRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowReleaseRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PacketSendHeights) > 0 {
+		for iNdEx := len(m.PacketSendHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketSendHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RefundRecords) > 0 {
+		for iNdEx := len(m.RefundRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.EscrowedTokens) > 0 {
+		for iNdEx := len(m.EscrowedTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PendingSendPackets) > 0 {
+		for iNdEx := len(m.PendingSendPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RateLimitFlows) > 0 {
+		for iNdEx := len(m.RateLimitFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.InFlightPackets) > 0 {
+		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -197,6 +415,60 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InFlightPackets) > 0 {
+		for _, e := range m.InFlightPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitFlows) > 0 {
+		for _, e := range m.RateLimitFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSendPackets) > 0 {
+		for _, e := range m.PendingSendPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowedTokens) > 0 {
+		for _, e := range m.EscrowedTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RefundRecords) > 0 {
+		for _, e := range m.RefundRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketSendHeights) > 0 {
+		for _, e := range m.PacketSendHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowReleaseRecords) > 0 {
+		for _, e := range m.EscrowReleaseRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Pauses) > 0 {
+		for _, e := range m.Pauses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -334,6 +606,312 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightPackets = append(m.InFlightPackets, InFlightPacket{})
+			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitFlows = append(m.RateLimitFlows, RateLimitFlow{})
+			if err := m.RateLimitFlows[len(m.RateLimitFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSendPackets = append(m.PendingSendPackets, PendingSendPacket{})
+			if err := m.PendingSendPackets[len(m.PendingSendPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedTokens = append(m.EscrowedTokens, EscrowedToken{})
+			if err := m.EscrowedTokens[len(m.EscrowedTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundRecords = append(m.RefundRecords, RefundRecord{})
+			if err := m.RefundRecords[len(m.RefundRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSendHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSendHeights = append(m.PacketSendHeights, PacketSendHeight{})
+			if err := m.PacketSendHeights[len(m.PacketSendHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowReleaseRecords = append(m.EscrowReleaseRecords, EscrowReleaseRecord{})
+			if err := m.EscrowReleaseRecords[len(m.EscrowReleaseRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pauses = append(m.Pauses, Pause{})
+			if err := m.Pauses[len(m.Pauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
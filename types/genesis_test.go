@@ -22,6 +22,121 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid escrowed tokens",
+			&GenesisState{
+				PortId: PortID,
+				EscrowedTokens: []EscrowedToken{
+					NewEscrowedToken(PortID, "channel-0", "kitty", "1"),
+					NewEscrowedToken(PortID, "channel-0", "kitty", "2"),
+				},
+			},
+			false,
+		},
+		{
+			"duplicate escrowed token",
+			&GenesisState{
+				PortId: PortID,
+				EscrowedTokens: []EscrowedToken{
+					NewEscrowedToken(PortID, "channel-0", "kitty", "1"),
+					NewEscrowedToken(PortID, "channel-1", "kitty", "1"),
+				},
+			},
+			true,
+		},
+		{
+			"valid refund records",
+			&GenesisState{
+				PortId: PortID,
+				RefundRecords: []RefundRecord{
+					NewRefundRecord(PortID, "channel-0", 1, sender),
+				},
+			},
+			false,
+		},
+		{
+			"invalid refund record sequence",
+			&GenesisState{
+				PortId: PortID,
+				RefundRecords: []RefundRecord{
+					NewRefundRecord(PortID, "channel-0", 0, sender),
+				},
+			},
+			true,
+		},
+		{
+			"invalid refund record address",
+			&GenesisState{
+				PortId: PortID,
+				RefundRecords: []RefundRecord{
+					NewRefundRecord(PortID, "channel-0", 1, "refund"),
+				},
+			},
+			true,
+		},
+		{
+			"invalid packet send height",
+			&GenesisState{
+				PortId: PortID,
+				PacketSendHeights: []PacketSendHeight{
+					NewPacketSendHeight(PortID, "channel-0", 0, 10),
+				},
+			},
+			true,
+		},
+		{
+			"valid escrow release records",
+			&GenesisState{
+				PortId: PortID,
+				EscrowReleaseRecords: []EscrowReleaseRecord{
+					{Id: 1, PortId: PortID, ChannelId: "channel-0", Releases: []EscrowRelease{NewEscrowRelease("kitty", "1", receiver)}, Evidence: "evidence", Height: 10},
+					{Id: 2, PortId: PortID, ChannelId: "channel-0", Releases: []EscrowRelease{NewEscrowRelease("kitty", "2", receiver)}, Evidence: "evidence", Height: 10},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate escrow release record id",
+			&GenesisState{
+				PortId: PortID,
+				EscrowReleaseRecords: []EscrowReleaseRecord{
+					{Id: 1, PortId: PortID, ChannelId: "channel-0", Releases: []EscrowRelease{NewEscrowRelease("kitty", "1", receiver)}, Evidence: "evidence", Height: 10},
+					{Id: 1, PortId: PortID, ChannelId: "channel-0", Releases: []EscrowRelease{NewEscrowRelease("kitty", "2", receiver)}, Evidence: "evidence", Height: 10},
+				},
+			},
+			true,
+		},
+		{
+			"invalid escrow release record id",
+			&GenesisState{
+				PortId: PortID,
+				EscrowReleaseRecords: []EscrowReleaseRecord{
+					{Id: 0, PortId: PortID, ChannelId: "channel-0", Releases: []EscrowRelease{NewEscrowRelease("kitty", "1", receiver)}, Evidence: "evidence", Height: 10},
+				},
+			},
+			true,
+		},
+		{
+			"valid pauses",
+			&GenesisState{
+				PortId: PortID,
+				Pauses: []Pause{
+					NewPause(PortID, "channel-0", ""),
+					NewPause("", "", "kitty"),
+				},
+			},
+			false,
+		},
+		{
+			"invalid pause",
+			&GenesisState{
+				PortId: PortID,
+				Pauses: []Pause{
+					NewPause(PortID, "", "kitty"),
+				},
+			},
+			true,
+		},
 		{
 			"invalid client",
 			&GenesisState{
@@ -0,0 +1,103 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The names of the hooks reported by the transfer_hook_failed event.
+const (
+	HookAfterAcknowledgementSuccess = "AfterAcknowledgementSuccess"
+	HookAfterRefund                 = "AfterRefund"
+)
+
+// ResolvedPacketHookGasLimit is the gas available to each of the
+// AfterAcknowledgementSuccess and AfterRefund hooks.
+const ResolvedPacketHookGasLimit uint64 = 1_000_000
+
+// TransferInfo describes the non-fungible tokens leaving or arriving through
+// IBC passed to the TransferHooks.
+type TransferInfo struct {
+	// the port and channel of this chain the tokens are sent or received over
+	PortID    string
+	ChannelID string
+	// the trace of the class of the tokens on this chain
+	ClassTrace ClassTrace
+	// the classID of the tokens on this chain, e.g. ibc/{hash} for vouchers
+	VoucherClassID string
+	TokenIDs       []string
+	Sender         string
+	Receiver       string
+}
+
+// TransferHooks defines the hooks other modules may register with the
+// nft-transfer keeper to react to non-fungible tokens leaving or arriving
+// through IBC. Returning an error aborts the transfer: the sending transaction
+// fails for BeforeSendTransfer and an error acknowledgement is written for
+// AfterReceive. AfterAcknowledgementSuccess and AfterRefund cannot abort the
+// transfer, which is already resolved: they run with at most
+// ResolvedPacketHookGasLimit gas, and the state changes of a hook which fails,
+// panics or runs out of gas are discarded and a transfer_hook_failed event is
+// emitted instead.
+type TransferHooks interface {
+	// BeforeSendTransfer is called before the tokens are escrowed or burnt to be sent.
+	BeforeSendTransfer(ctx sdk.Context, info TransferInfo) error
+	// AfterReceive is called once the received tokens are minted or unescrowed.
+	AfterReceive(ctx sdk.Context, info TransferInfo) error
+	// AfterAcknowledgementSuccess is called once the tokens sent are acknowledged
+	// as received by the counterparty chain.
+	AfterAcknowledgementSuccess(ctx sdk.Context, info TransferInfo) error
+	// AfterRefund is called once the tokens sent are refunded to the sender,
+	// following an error acknowledgement or a timeout.
+	AfterRefund(ctx sdk.Context, info TransferInfo) error
+}
+
+var _ TransferHooks = MultiTransferHooks{}
+
+// MultiTransferHooks combines multiple transfer hooks, all hook functions are
+// run in array sequence. The first error returned aborts the sequence.
+type MultiTransferHooks []TransferHooks
+
+// NewMultiTransferHooks creates a new MultiTransferHooks from the given hooks.
+func NewMultiTransferHooks(hooks ...TransferHooks) MultiTransferHooks {
+	return hooks
+}
+
+// BeforeSendTransfer implements the TransferHooks interface.
+func (h MultiTransferHooks) BeforeSendTransfer(ctx sdk.Context, info TransferInfo) error {
+	for i := range h {
+		if err := h[i].BeforeSendTransfer(ctx, info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterReceive implements the TransferHooks interface.
+func (h MultiTransferHooks) AfterReceive(ctx sdk.Context, info TransferInfo) error {
+	for i := range h {
+		if err := h[i].AfterReceive(ctx, info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterAcknowledgementSuccess implements the TransferHooks interface.
+func (h MultiTransferHooks) AfterAcknowledgementSuccess(ctx sdk.Context, info TransferInfo) error {
+	for i := range h {
+		if err := h[i].AfterAcknowledgementSuccess(ctx, info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterRefund implements the TransferHooks interface.
+func (h MultiTransferHooks) AfterRefund(ctx sdk.Context, info TransferInfo) error {
+	for i := range h {
+		if err := h[i].AfterRefund(ctx, info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
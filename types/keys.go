@@ -3,6 +3,7 @@ package types
 import (
 	"crypto/sha256"
 	"fmt"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -11,9 +12,17 @@ const (
 	// ModuleName defines the IBC nft-transfer name
 	ModuleName = "nonfungibletokentransfer"
 
-	// Version defines the current version the IBC nft-transfer
-	// module supports
-	Version = "ics721-1"
+	// V1 defines the version of the IBC nft-transfer module whose packet data
+	// carries the class trace joined with the base classID in the classID
+	V1 = "ics721-1"
+
+	// V2 defines the version of the IBC nft-transfer module whose packet data
+	// also carries the class trace and the base classID separately
+	V2 = "ics721-2"
+
+	// Version defines the default version the IBC nft-transfer
+	// module negotiates
+	Version = V1
 
 	// PortID is the default port id that nft-transfer module binds to
 	PortID = "nft-transfer"
@@ -40,8 +49,62 @@ var (
 
 	// ParamsKey is the key to query all nft_transfer params
 	ParamsKey = []byte{0x03}
+
+	// InFlightPacketKey defines the key to store the packets waiting for the
+	// acknowledgement of the packets forwarded on their behalf
+	InFlightPacketKey = []byte{0x04}
+
+	// RateLimitKey defines the key to store the rate limits in store
+	RateLimitKey = []byte{0x05}
+
+	// RateLimitFlowKey defines the key to store the flows of the rate limits in store
+	RateLimitFlowKey = []byte{0x06}
+
+	// PendingSendPacketKey defines the key to store the sent packets counted
+	// against a rate limit which are not acknowledged yet
+	PendingSendPacketKey = []byte{0x07}
+
+	// EscrowedTokenKey defines the key to index the escrowed tokens by port and channel
+	EscrowedTokenKey = []byte{0x08}
+
+	// TokenEscrowKey defines the key to index the escrowed tokens by class and token
+	TokenEscrowKey = []byte{0x09}
+
+	// EscrowedClassCountKey defines the key to store the number of escrowed
+	// tokens per port, channel and class
+	EscrowedClassCountKey = []byte{0x0a}
+
+	// RefundRecordKey defines the key to store the refund addresses of the
+	// packets sent which are not acknowledged yet
+	RefundRecordKey = []byte{0x0b}
+
+	// PacketSendHeightKey defines the key to store the heights at which the
+	// packets not acknowledged yet were sent
+	PacketSendHeightKey = []byte{0x0c}
+
+	// EscrowReleaseRecordKey defines the key to store the records of the tokens
+	// released by governance from escrow
+	EscrowReleaseRecordKey = []byte{0x0d}
+
+	// NextEscrowReleaseIDKey defines the key to store the id of the next
+	// record of the tokens released by governance from escrow
+	NextEscrowReleaseIDKey = []byte{0x0e}
+
+	// PauseKey defines the key to store the channels and classes whose
+	// transfers are paused
+	PauseKey = []byte{0x0f}
 )
 
+// SupportedVersions defines the versions of the IBC nft-transfer module a
+// channel may be opened or upgraded with
+var SupportedVersions = []string{V1, V2}
+
+// IsSupportedVersion returns true if the given version is supported by the
+// IBC nft-transfer module
+func IsSupportedVersion(version string) bool {
+	return slices.Contains(SupportedVersions, version)
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
@@ -50,8 +113,9 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	// prevent address collisions between escrow addresses created for different channels
 	contents := fmt.Sprintf("%s/%s", portID, channelID)
 
-	// ADR 028 AddressHash construction
-	preImage := []byte(Version)
+	// ADR 028 AddressHash construction. The escrow address does not depend on
+	// the version of the channel, so that it is kept across channel upgrades.
+	preImage := []byte(V1)
 	preImage = append(preImage, 0)
 	preImage = append(preImage, contents...)
 	hash := sha256.Sum256(preImage)
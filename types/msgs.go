@@ -14,9 +14,14 @@ import (
 
 // msg types
 const (
-	TypeMsgTransfer = "nft-transfer"
+	TypeMsgTransfer      = "nft-transfer"
+	TypeMsgBatchTransfer = "nft-batch-transfer"
 )
 
+// BatchTransferGasPerToken is the gas consumed for each token transferred by a
+// MsgBatchTransfer, on top of the gas consumed by the transfers themselves.
+const BatchTransferGasPerToken uint64 = 1000
+
 // NewMsgTransfer creates a new MsgTransfer instance
 //
 //nolint:interfacer
@@ -64,12 +69,22 @@ func (msg MsgTransfer) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidClassID, "classId cannot be blank")
 	}
 
-	if len(msg.TokenIds) == 0 {
+	if err := validateTokenIDs(msg.TokenIds); err != nil {
+		return err
+	}
+
+	return validateTransferAddresses(msg.Sender, msg.Receiver, msg.RefundAddress)
+}
+
+// validateTokenIDs checks that the token IDs transferred are neither empty nor
+// repeated.
+func validateTokenIDs(tokenIDs []string) error {
+	if len(tokenIDs) == 0 {
 		return errorsmod.Wrap(ErrInvalidTokenID, "tokenId cannot be blank")
 	}
 
 	seen := make(map[string]int64)
-	for i, id := range msg.TokenIds {
+	for i, id := range tokenIDs {
 		if strings.TrimSpace(id) == "" {
 			return errorsmod.Wrap(ErrInvalidTokenID, "tokenId cannot be blank")
 		}
@@ -78,15 +93,25 @@ func (msg MsgTransfer) ValidateBasic() error {
 		}
 		seen[id] = int64(i)
 	}
+	return nil
+}
 
+// validateTransferAddresses checks the sender, the receiver and the optional
+// refund address of a transfer.
+func validateTransferAddresses(sender, receiver, refundAddress string) error {
 	// NOTE: sender format must be validated as it is required by the GetSigners function.
-	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	_, err := sdk.AccAddressFromBech32(sender)
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
-	if strings.TrimSpace(msg.Receiver) == "" {
+	if strings.TrimSpace(receiver) == "" {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
 	}
+	if refundAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(refundAddress); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid refund address: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -104,6 +129,90 @@ func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+// NewMsgBatchTransfer creates a new MsgBatchTransfer instance
+func NewMsgBatchTransfer(
+	sourcePort, sourceChannel string,
+	classTokens []ClassTokens, sender, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64, memo string,
+) *MsgBatchTransfer {
+	return &MsgBatchTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		ClassTokens:      classTokens,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgBatchTransfer) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgBatchTransfer) Type() string {
+	return TypeMsgBatchTransfer
+}
+
+// ValidateBasic performs a basic check of the MsgBatchTransfer fields. Each
+// class may be given once only.
+// NOTE: timeout height or timestamp values can be 0 to disable the timeout.
+func (msg MsgBatchTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return errorsmod.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return errorsmod.Wrap(err, "invalid source channel ID")
+	}
+
+	if len(msg.ClassTokens) == 0 {
+		return errorsmod.Wrap(ErrInvalidClassID, "classTokens cannot be empty")
+	}
+
+	seen := make(map[string]int)
+	for i, classTokens := range msg.ClassTokens {
+		if strings.TrimSpace(classTokens.ClassId) == "" {
+			return errorsmod.Wrap(ErrInvalidClassID, "classId cannot be blank")
+		}
+		if j, exist := seen[classTokens.ClassId]; exist {
+			return errorsmod.Wrapf(ErrInvalidClassID, "the classId at positions %d and %d in the array are repeated", i, j)
+		}
+		seen[classTokens.ClassId] = i
+
+		if err := validateTokenIDs(classTokens.TokenIds); err != nil {
+			return errorsmod.Wrapf(err, "classId %s", classTokens.ClassId)
+		}
+	}
+
+	return validateTransferAddresses(msg.Sender, msg.Receiver, msg.RefundAddress)
+}
+
+// TokenCount returns the number of tokens transferred by the MsgBatchTransfer.
+func (msg MsgBatchTransfer) TokenCount() uint64 {
+	var count uint64
+	for _, classTokens := range msg.ClassTokens {
+		count += uint64(len(classTokens.TokenIds))
+	}
+	return count
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgBatchTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgBatchTransfer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
 // ValidateBasic implements the sdk.Msg interface.
 func (msg MsgUpdateParams) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
@@ -111,7 +220,7 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
 	}
 
-	return nil
+	return msg.Params.Validate()
 }
 
 // GetSignBytes returns the message bytes to sign over.
@@ -128,3 +237,227 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{authority}
 }
+
+// NewMsgSetRateLimit creates a new MsgSetRateLimit instance
+func NewMsgSetRateLimit(authority string, rateLimit RateLimit) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		Authority: authority,
+		RateLimit: rateLimit,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.RateLimit.Validate()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSetRateLimit) GetSignBytes() []byte {
+	bz := AminoCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgSetRateLimit.
+func (msg MsgSetRateLimit) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgRemoveRateLimit creates a new MsgRemoveRateLimit instance
+func NewMsgRemoveRateLimit(authority, portID, channelID, classID string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Authority: authority,
+		PortId:    portID,
+		ChannelId: channelID,
+		ClassId:   classID,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRemoveRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return validateRateLimitPath(msg.PortId, msg.ChannelId, msg.ClassId)
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRemoveRateLimit) GetSignBytes() []byte {
+	bz := AminoCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgRemoveRateLimit.
+func (msg MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgMigrateEscrow creates a new MsgMigrateEscrow instance
+func NewMsgMigrateEscrow(
+	authority, sourcePort, sourceChannel, destinationPort, destinationChannel, classID string,
+) *MsgMigrateEscrow {
+	return &MsgMigrateEscrow{
+		Authority:            authority,
+		SourcePortId:         sourcePort,
+		SourceChannelId:      sourceChannel,
+		DestinationPortId:    destinationPort,
+		DestinationChannelId: destinationChannel,
+		ClassId:              classID,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgMigrateEscrow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := host.PortIdentifierValidator(msg.SourcePortId); err != nil {
+		return errorsmod.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid source channel ID")
+	}
+	if err := host.PortIdentifierValidator(msg.DestinationPortId); err != nil {
+		return errorsmod.Wrap(err, "invalid destination port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.DestinationChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid destination channel ID")
+	}
+	if msg.SourcePortId == msg.DestinationPortId && msg.SourceChannelId == msg.DestinationChannelId {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "source and destination channels cannot be the same")
+	}
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgMigrateEscrow) GetSignBytes() []byte {
+	bz := AminoCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgMigrateEscrow.
+func (msg MsgMigrateEscrow) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgReleaseEscrow creates a new MsgReleaseEscrow instance
+func NewMsgReleaseEscrow(authority, portID, channelID string, releases []EscrowRelease, evidence string) *MsgReleaseEscrow {
+	return &MsgReleaseEscrow{
+		Authority: authority,
+		PortId:    portID,
+		ChannelId: channelID,
+		Releases:  releases,
+		Evidence:  evidence,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgReleaseEscrow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateEscrowReleases(msg.PortId, msg.ChannelId, msg.Releases, msg.Evidence)
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgReleaseEscrow) GetSignBytes() []byte {
+	bz := AminoCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgReleaseEscrow.
+func (msg MsgReleaseEscrow) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgPause creates a new MsgPause instance
+func NewMsgPause(signer string, pause Pause) *MsgPause {
+	return &MsgPause{
+		Signer: signer,
+		Pause:  pause,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgPause) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+
+	return msg.Pause.Validate()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgPause) GetSignBytes() []byte {
+	bz := AminoCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgPause.
+func (msg MsgPause) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgUnpause creates a new MsgUnpause instance
+func NewMsgUnpause(signer string, pause Pause) *MsgUnpause {
+	return &MsgUnpause{
+		Signer: signer,
+		Pause:  pause,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUnpause) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+
+	return msg.Pause.Validate()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUnpause) GetSignBytes() []byte {
+	bz := AminoCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgUnpause.
+func (msg MsgUnpause) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
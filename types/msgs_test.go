@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -27,6 +28,8 @@ func TestMsgTransfer_ValidateBasic(t *testing.T) {
 		{"invalid msg with token_id", NewMsgTransfer("nft-transfer", "channel-1", "cryptoCat", []string{""}, sender, receiver, clienttypes.NewHeight(1, 1), 1, "memo"), true},
 		{"invalid msg with sender", NewMsgTransfer("nft-transfer", "channel-1", "cryptoCat", []string{"kitty"}, "", receiver, clienttypes.NewHeight(1, 1), 1, "memo"), true},
 		{"invalid msg with receiver", NewMsgTransfer("nft-transfer", "channel-1", "cryptoCat", []string{"kitty"}, sender, "", clienttypes.NewHeight(1, 1), 1, "memo"), true},
+		{"valid msg with refund address", withRefundAddress(NewMsgTransfer("nft-transfer", "channel-1", "cryptoCat", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1, "memo"), sender), false},
+		{"invalid msg with refund address", withRefundAddress(NewMsgTransfer("nft-transfer", "channel-1", "cryptoCat", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1, "memo"), "refund"), true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -36,3 +39,114 @@ func TestMsgTransfer_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func withRefundAddress(msg *MsgTransfer, refundAddress string) *MsgTransfer {
+	msg.RefundAddress = refundAddress
+	return msg
+}
+
+func TestMsgBatchTransfer_ValidateBasic(t *testing.T) {
+	cats := ClassTokens{ClassId: "cryptoCat", TokenIds: []string{"kitty1", "kitty2"}}
+	dogs := ClassTokens{ClassId: "cryptoDog", TokenIds: []string{"puppy"}}
+	newMsg := func(sender string, classTokens ...ClassTokens) *MsgBatchTransfer {
+		return NewMsgBatchTransfer("nft-transfer", "channel-1", classTokens, sender, receiver, clienttypes.NewHeight(1, 1), 1, "memo")
+	}
+	tests := []struct {
+		name    string
+		msg     *MsgBatchTransfer
+		wantErr bool
+	}{
+		{"valid msg", newMsg(sender, cats, dogs), false},
+		{"valid msg with a single class", newMsg(sender, cats), false},
+		{"invalid msg without classes", newMsg(sender), true},
+		{"invalid msg with blank class", newMsg(sender, cats, ClassTokens{ClassId: " ", TokenIds: []string{"puppy"}}), true},
+		{"invalid msg with repeated class", newMsg(sender, cats, dogs, cats), true},
+		{"invalid msg without tokens", newMsg(sender, cats, ClassTokens{ClassId: "cryptoDog"}), true},
+		{"invalid msg with repeated token", newMsg(sender, ClassTokens{ClassId: "cryptoCat", TokenIds: []string{"kitty", "kitty"}}), true},
+		{"invalid msg with sender", newMsg("", cats), true},
+		{"invalid msg with channel", NewMsgBatchTransfer("nft-transfer", "@channel-1", []ClassTokens{cats}, sender, receiver, clienttypes.NewHeight(1, 1), 1, "memo"), true},
+		{"invalid msg with receiver", NewMsgBatchTransfer("nft-transfer", "channel-1", []ClassTokens{cats}, sender, "", clienttypes.NewHeight(1, 1), 1, "memo"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("MsgBatchTransfer.ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if count := newMsg(sender, cats, dogs).TokenCount(); count != 3 {
+		t.Errorf("MsgBatchTransfer.TokenCount() = %d, want 3", count)
+	}
+}
+
+func TestMsgMigrateEscrow_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     *MsgMigrateEscrow
+		wantErr bool
+	}{
+		{"valid msg", NewMsgMigrateEscrow(sender, "nft-transfer", "channel-0", "nft-transfer", "channel-1", ""), false},
+		{"valid msg with class", NewMsgMigrateEscrow(sender, "nft-transfer", "channel-0", "nft-transfer", "channel-1", "cryptoCat"), false},
+		{"invalid msg with authority", NewMsgMigrateEscrow("authority", "nft-transfer", "channel-0", "nft-transfer", "channel-1", ""), true},
+		{"invalid msg with source channel", NewMsgMigrateEscrow(sender, "nft-transfer", "@channel-0", "nft-transfer", "channel-1", ""), true},
+		{"invalid msg with destination port", NewMsgMigrateEscrow(sender, "nft-transfer", "channel-0", "@nft-transfer", "channel-1", ""), true},
+		{"invalid msg with same channels", NewMsgMigrateEscrow(sender, "nft-transfer", "channel-0", "nft-transfer", "channel-0", ""), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("MsgMigrateEscrow.ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMsgReleaseEscrow_ValidateBasic(t *testing.T) {
+	release := NewEscrowRelease("cryptoCat", "kitty", receiver)
+	tests := []struct {
+		name    string
+		msg     *MsgReleaseEscrow
+		wantErr bool
+	}{
+		{"valid msg", NewMsgReleaseEscrow(sender, "nft-transfer", "channel-0", []EscrowRelease{release}, "evidence"), false},
+		{"invalid msg with authority", NewMsgReleaseEscrow("authority", "nft-transfer", "channel-0", []EscrowRelease{release}, "evidence"), true},
+		{"invalid msg with channel", NewMsgReleaseEscrow(sender, "nft-transfer", "@channel-0", []EscrowRelease{release}, "evidence"), true},
+		{"invalid msg without releases", NewMsgReleaseEscrow(sender, "nft-transfer", "channel-0", nil, "evidence"), true},
+		{"invalid msg with duplicate releases", NewMsgReleaseEscrow(sender, "nft-transfer", "channel-0", []EscrowRelease{release, release}, "evidence"), true},
+		{"invalid msg with receiver", NewMsgReleaseEscrow(sender, "nft-transfer", "channel-0", []EscrowRelease{NewEscrowRelease("cryptoCat", "kitty", "owner")}, "evidence"), true},
+		{"invalid msg with blank evidence", NewMsgReleaseEscrow(sender, "nft-transfer", "channel-0", []EscrowRelease{release}, " "), true},
+		{"invalid msg with long evidence", NewMsgReleaseEscrow(sender, "nft-transfer", "channel-0", []EscrowRelease{release}, strings.Repeat("e", MaxEvidenceLength+1)), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("MsgReleaseEscrow.ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMsgPause_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     *MsgPause
+		wantErr bool
+	}{
+		{"valid msg with channel", NewMsgPause(sender, NewPause("nft-transfer", "channel-0", "")), false},
+		{"valid msg with class", NewMsgPause(sender, NewPause("", "", "cryptoCat")), false},
+		{"valid msg with class on channel", NewMsgPause(sender, NewPause("nft-transfer", "channel-0", "cryptoCat")), false},
+		{"invalid msg with signer", NewMsgPause("guardian", NewPause("nft-transfer", "channel-0", "")), true},
+		{"invalid msg without channel and class", NewMsgPause(sender, NewPause("", "", "")), true},
+		{"invalid msg with port only", NewMsgPause(sender, NewPause("nft-transfer", "", "cryptoCat")), true},
+		{"invalid msg with channel", NewMsgPause(sender, NewPause("nft-transfer", "@channel-0", "")), true},
+		{"invalid msg with blank class", NewMsgPause(sender, NewPause("", "", " ")), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("MsgPause.ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -8,6 +9,14 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var (
+	_ ibcexported.PacketData         = (*NonFungibleTokenPacketData)(nil)
+	_ ibcexported.PacketDataProvider = (*NonFungibleTokenPacketData)(nil)
 )
 
 var (
@@ -75,6 +84,10 @@ func (nftpd NonFungibleTokenPacketData) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidPacket, "the length of tokenData must be 0 or the same as the length of TokenIds")
 	}
 
+	if err := nftpd.validateClassTrace(); err != nil {
+		return err
+	}
+
 	if strings.TrimSpace(nftpd.Sender) == "" {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
 	}
@@ -85,6 +98,51 @@ func (nftpd NonFungibleTokenPacketData) ValidateBasic() error {
 	return nil
 }
 
+// validateClassTrace checks that the class trace and the base classID, when
+// carried by the packet data, make up the classID.
+func (nftpd NonFungibleTokenPacketData) validateClassTrace() error {
+	if !nftpd.HasClassTrace() {
+		if len(nftpd.Trace) != 0 {
+			return errorsmod.Wrap(ErrInvalidPacket, "base classId cannot be blank when the class trace is set")
+		}
+		return nil
+	}
+
+	for i, hop := range nftpd.Trace {
+		if err := host.PortIdentifierValidator(hop.PortId); err != nil {
+			return errorsmod.Wrapf(err, "invalid port ID at hop %d", i)
+		}
+		if err := host.ChannelIdentifierValidator(hop.ChannelId); err != nil {
+			return errorsmod.Wrapf(err, "invalid channel ID at hop %d", i)
+		}
+	}
+
+	if fullClassPath := nftpd.GetClassTrace().GetFullClassPath(); fullClassPath != nftpd.ClassId {
+		return errorsmod.Wrapf(ErrInvalidClassID, "class trace %s does not match classId %s", fullClassPath, nftpd.ClassId)
+	}
+	return nil
+}
+
+// HasClassTrace returns true if the packet data carries the class trace and the
+// base classID separately, as sent over ics721-2 channels.
+func (nftpd NonFungibleTokenPacketData) HasClassTrace() bool {
+	return nftpd.BaseClassId != ""
+}
+
+// GetClassTrace returns the class trace carried by the packet data. It must only
+// be used if HasClassTrace returns true.
+func (nftpd NonFungibleTokenPacketData) GetClassTrace() ClassTrace {
+	return NewClassTraceFromHops(nftpd.Trace, nftpd.BaseClassId)
+}
+
+// WithClassTrace returns the packet data carrying the given class trace and its
+// base classID separately, as sent over ics721-2 channels.
+func (nftpd NonFungibleTokenPacketData) WithClassTrace(classTrace ClassTrace) NonFungibleTokenPacketData {
+	nftpd.Trace = classTrace.GetHops()
+	nftpd.BaseClassId = classTrace.BaseClassId
+	return nftpd
+}
+
 // GetBytes is a helper for serializing
 func (nftpd NonFungibleTokenPacketData) GetBytes() []byte {
 	// Format will reshape tokenUris and tokenData in NonFungibleTokenPacketData:
@@ -101,6 +159,101 @@ func (nftpd NonFungibleTokenPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(MustProtoMarshalJSON(&nftpd))
 }
 
+// Split splits the packet data into several packets of at most maxTokens tokens
+// whose bytes are at most maxBytes, each carrying the class, sender, receiver
+// and memo of the packet data and the tokens in order. A limit of 0 is
+// disabled. The packet data is returned unchanged if it is within the limits,
+// and an error is returned if a single token does not fit in maxBytes.
+func (nftpd NonFungibleTokenPacketData) Split(maxTokens, maxBytes uint64) ([]NonFungibleTokenPacketData, error) {
+	if nftpd.withinLimits(maxTokens, maxBytes) {
+		return []NonFungibleTokenPacketData{nftpd}, nil
+	}
+
+	// a chunk is closed before the token which takes it over the limits, the
+	// bytes of each candidate chunk being measured as they are sent
+	var (
+		packets []NonFungibleTokenPacketData
+		start   int
+	)
+	for end := 1; end <= len(nftpd.TokenIds); end++ {
+		if nftpd.slice(start, end).withinLimits(maxTokens, maxBytes) {
+			continue
+		}
+		if end-1 > start {
+			packets = append(packets, nftpd.slice(start, end-1))
+			start = end - 1
+		}
+		if !nftpd.slice(start, end).withinLimits(maxTokens, maxBytes) {
+			return nil, errorsmod.Wrapf(ErrPacketTooLarge, "tokenId %s exceeds the maximum packet size of %d bytes", nftpd.TokenIds[start], maxBytes)
+		}
+	}
+	return append(packets, nftpd.slice(start, len(nftpd.TokenIds))), nil
+}
+
+// withinLimits reports whether the packet data carries at most maxTokens tokens
+// and its bytes are at most maxBytes. A limit of 0 is disabled.
+func (nftpd NonFungibleTokenPacketData) withinLimits(maxTokens, maxBytes uint64) bool {
+	if maxTokens != 0 && uint64(len(nftpd.TokenIds)) > maxTokens {
+		return false
+	}
+	return maxBytes == 0 || uint64(len(nftpd.GetBytes())) <= maxBytes
+}
+
+// slice returns the packet data carrying the tokens from start to end.
+func (nftpd NonFungibleTokenPacketData) slice(start, end int) NonFungibleTokenPacketData {
+	nftpd.TokenIds = nftpd.TokenIds[start:end]
+	if len(nftpd.TokenUris) != 0 {
+		nftpd.TokenUris = nftpd.TokenUris[start:end]
+	}
+	if len(nftpd.TokenData) != 0 {
+		nftpd.TokenData = nftpd.TokenData[start:end]
+	}
+	return nftpd
+}
+
+// GetPacketSender returns the sender address embedded in the packet data.
+//
+// NOTE:
+//   - The sender address is set by the module which requested the packet to be sent,
+//     and this module may not have validated the sender address by a signature check.
+//   - The sender address must only be used by modules on the sending chain.
+//   - sourcePortID is not used in this implementation.
+func (nftpd NonFungibleTokenPacketData) GetPacketSender(sourcePortID string) string {
+	return nftpd.Sender
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key, e.g. "src_callback" or
+// "dest_callback". If the key is missing or the memo is not properly formatted,
+// then nil is returned.
+func (nftpd NonFungibleTokenPacketData) GetCustomPacketData(key string) interface{} {
+	if len(nftpd.Memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(nftpd.Memo), &jsonObject); err != nil {
+		return nil
+	}
+
+	memoData, found := jsonObject[key]
+	if !found {
+		return nil
+	}
+	return memoData
+}
+
+// HasMemoInstructions reports whether the memo of the packet data carries
+// forwarding or callback instructions.
+func (nftpd NonFungibleTokenPacketData) HasMemoInstructions() bool {
+	for _, key := range []string{ForwardMemoKey, SourceCallbackMemoKey, DestinationCallbackMemoKey} {
+		if nftpd.GetCustomPacketData(key) != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func GetIfExist(i int, data []string) string {
 	if i < 0 || i >= len(data) {
 		return ""
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -44,6 +45,12 @@ type NonFungibleTokenPacketData struct {
 	Receiver string `protobuf:"bytes,8,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// optional memo
 	Memo string `protobuf:"bytes,9,opt,name=memo,proto3" json:"memo,omitempty"`
+	// the trace of the class on the sending chain, starting from the last hop.
+	// Only set on ics721-2 channels
+	Trace []Hop `protobuf:"bytes,10,rep,name=trace,proto3" json:"trace"`
+	// the base class_id of the class to be transferred. Only set on ics721-2
+	// channels
+	BaseClassId string `protobuf:"bytes,11,opt,name=base_class_id,json=baseClassId,proto3" json:"base_class_id,omitempty"`
 }
 
 func (m *NonFungibleTokenPacketData) Reset()         { *m = NonFungibleTokenPacketData{} }
@@ -142,8 +149,152 @@ func (m *NonFungibleTokenPacketData) GetMemo() string {
 	return ""
 }
 
+func (m *NonFungibleTokenPacketData) GetTrace() []Hop {
+	if m != nil {
+		return m.Trace
+	}
+	return nil
+}
+
+func (m *NonFungibleTokenPacketData) GetBaseClassId() string {
+	if m != nil {
+		return m.BaseClassId
+	}
+	return ""
+}
+
+// Hop defines a port ID, channel ID pair of the trace of a class
+type Hop struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *Hop) Reset()         { *m = Hop{} }
+func (m *Hop) String() string { return proto.CompactTextString(m) }
+func (*Hop) ProtoMessage()    {}
+func (*Hop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f82fdc932b824013, []int{1}
+}
+func (m *Hop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Hop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Hop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hop.Merge(m, src)
+}
+func (m *Hop) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hop) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hop proto.InternalMessageInfo
+
+func (m *Hop) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *Hop) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// NonFungibleTokenPacketResult defines the result of the success
+// acknowledgement of a received packet
+type NonFungibleTokenPacketResult struct {
+	// the version of the result
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// the class_id of the tokens on the receiving chain, ibc/{hash} for vouchers
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// the hash of the class trace of the vouchers, empty if the tokens are
+	// returned to their chain of origin
+	ClassTraceHash string `protobuf:"bytes,3,opt,name=class_trace_hash,json=classTraceHash,proto3" json:"class_trace_hash,omitempty"`
+	// the receiver of the tokens on the receiving chain
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *NonFungibleTokenPacketResult) Reset()         { *m = NonFungibleTokenPacketResult{} }
+func (m *NonFungibleTokenPacketResult) String() string { return proto.CompactTextString(m) }
+func (*NonFungibleTokenPacketResult) ProtoMessage()    {}
+func (*NonFungibleTokenPacketResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f82fdc932b824013, []int{2}
+}
+func (m *NonFungibleTokenPacketResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NonFungibleTokenPacketResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NonFungibleTokenPacketResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NonFungibleTokenPacketResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonFungibleTokenPacketResult.Merge(m, src)
+}
+func (m *NonFungibleTokenPacketResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *NonFungibleTokenPacketResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonFungibleTokenPacketResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonFungibleTokenPacketResult proto.InternalMessageInfo
+
+func (m *NonFungibleTokenPacketResult) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *NonFungibleTokenPacketResult) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *NonFungibleTokenPacketResult) GetClassTraceHash() string {
+	if m != nil {
+		return m.ClassTraceHash
+	}
+	return ""
+}
+
+func (m *NonFungibleTokenPacketResult) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*NonFungibleTokenPacketData)(nil), "ibc.applications.nft_transfer.v1.NonFungibleTokenPacketData")
+	proto.RegisterType((*Hop)(nil), "ibc.applications.nft_transfer.v1.Hop")
+	proto.RegisterType((*NonFungibleTokenPacketResult)(nil), "ibc.applications.nft_transfer.v1.NonFungibleTokenPacketResult")
 }
 
 func init() {
@@ -151,27 +302,37 @@ func init() {
 }
 
 var fileDescriptor_f82fdc932b824013 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xc1, 0x4e, 0x32, 0x31,
-	0x14, 0x85, 0x19, 0xe0, 0x07, 0xa6, 0xcb, 0x2e, 0xfe, 0x54, 0x88, 0x13, 0xe2, 0x46, 0x37, 0xcc,
-	0x84, 0xf8, 0x04, 0x1a, 0x63, 0xc2, 0xc6, 0x18, 0x23, 0x1b, 0x37, 0xa4, 0xd3, 0xb9, 0xe0, 0x15,
-	0x68, 0x27, 0x6d, 0x87, 0xc4, 0xb7, 0x70, 0xe5, 0x33, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0x8b, 0x98,
-	0xb9, 0x55, 0x33, 0xbb, 0x9e, 0xfb, 0x9d, 0x7b, 0x6e, 0x93, 0xc3, 0x26, 0x98, 0xab, 0x4c, 0x96,
-	0xe5, 0x06, 0x95, 0xf4, 0x68, 0xb4, 0xcb, 0xf4, 0xd2, 0x2f, 0xbc, 0x95, 0xda, 0x2d, 0xc1, 0x66,
-	0xbb, 0x69, 0x56, 0x4a, 0xb5, 0x06, 0x9f, 0x96, 0xd6, 0x78, 0xc3, 0xc7, 0x98, 0xab, 0xb4, 0x69,
-	0x4f, 0x9b, 0xf6, 0x74, 0x37, 0x3d, 0x7b, 0x6f, 0xb3, 0xe1, 0x9d, 0xd1, 0xb7, 0x95, 0x5e, 0x61,
-	0xbe, 0x81, 0x47, 0xb3, 0x06, 0x7d, 0x4f, 0x11, 0x37, 0xd2, 0x4b, 0x7e, 0xc2, 0x06, 0x6a, 0x23,
-	0x9d, 0x5b, 0x60, 0x21, 0xa2, 0x71, 0x74, 0x11, 0x3f, 0xf4, 0x49, 0xcf, 0x0a, 0x3e, 0x62, 0x71,
-	0x40, 0x95, 0x45, 0xd1, 0x26, 0x16, 0xbc, 0x73, 0x8b, 0xfc, 0x94, 0xb1, 0x00, 0x0b, 0xe9, 0xa5,
-	0xe8, 0x10, 0x0d, 0x76, 0x8a, 0x1d, 0xb1, 0xd8, 0xd7, 0x97, 0x16, 0x58, 0x38, 0xd1, 0x1d, 0x77,
-	0xea, 0x5d, 0x1a, 0xcc, 0x0a, 0x57, 0xef, 0x06, 0x58, 0x59, 0x74, 0xe2, 0x1f, 0xd1, 0x60, 0x9f,
-	0x5b, 0x6c, 0x60, 0x8a, 0xee, 0x35, 0x30, 0x45, 0xff, 0x67, 0x3d, 0x07, 0xba, 0x00, 0x2b, 0xfa,
-	0x74, 0xf5, 0x47, 0xf1, 0x21, 0x1b, 0x58, 0x50, 0x80, 0x3b, 0xb0, 0x62, 0x10, 0x7e, 0xfb, 0xab,
-	0x39, 0x67, 0xdd, 0x2d, 0x6c, 0x8d, 0x88, 0x69, 0x4e, 0xef, 0xeb, 0xab, 0x8f, 0x43, 0x12, 0xed,
-	0x0f, 0x49, 0xf4, 0x75, 0x48, 0xa2, 0xb7, 0x63, 0xd2, 0xda, 0x1f, 0x93, 0xd6, 0xe7, 0x31, 0x69,
-	0x3d, 0x9d, 0xaf, 0xd0, 0x3f, 0x57, 0x79, 0xaa, 0xcc, 0x36, 0xcb, 0x51, 0xea, 0x17, 0x04, 0x89,
-	0x75, 0x0f, 0x93, 0xbf, 0x1e, 0xfc, 0x6b, 0x09, 0x2e, 0xef, 0x51, 0x09, 0x97, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x14, 0xf3, 0xab, 0xbb, 0xb5, 0x01, 0x00, 0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x4d, 0x8b, 0x13, 0x31,
+	0x18, 0xee, 0xb4, 0xdd, 0x7e, 0xbc, 0x65, 0x45, 0x82, 0x68, 0xec, 0xea, 0x58, 0x0a, 0x62, 0x2f,
+	0x3b, 0xc3, 0xea, 0xd9, 0xc3, 0xae, 0x22, 0xed, 0x45, 0xa4, 0xec, 0x5e, 0xbc, 0x0c, 0x99, 0x99,
+	0x6c, 0x27, 0xee, 0x34, 0x19, 0x92, 0x4c, 0xc1, 0x7f, 0xe1, 0xd5, 0x7f, 0xb4, 0xc7, 0x3d, 0x7a,
+	0x12, 0x69, 0x7f, 0x87, 0x20, 0x79, 0xd3, 0xad, 0x2d, 0x08, 0xde, 0xf2, 0x7c, 0xbc, 0x4f, 0x66,
+	0x9e, 0x37, 0x70, 0x2a, 0xd2, 0x2c, 0x66, 0x55, 0x55, 0x8a, 0x8c, 0x59, 0xa1, 0xa4, 0x89, 0xe5,
+	0xb5, 0x4d, 0xac, 0x66, 0xd2, 0x5c, 0x73, 0x1d, 0xaf, 0xce, 0xe2, 0x8a, 0x65, 0x37, 0xdc, 0x46,
+	0x95, 0x56, 0x56, 0x91, 0x91, 0x48, 0xb3, 0x68, 0xdf, 0x1e, 0xed, 0xdb, 0xa3, 0xd5, 0xd9, 0xf0,
+	0xd1, 0x42, 0x2d, 0x14, 0x9a, 0x63, 0x77, 0xf2, 0x73, 0xe3, 0xdf, 0x4d, 0x18, 0x7e, 0x54, 0xf2,
+	0x43, 0x2d, 0x17, 0x22, 0x2d, 0xf9, 0xa5, 0xba, 0xe1, 0xf2, 0x13, 0x06, 0xbf, 0x67, 0x96, 0x91,
+	0xa7, 0xd0, 0xcb, 0x4a, 0x66, 0x4c, 0x22, 0x72, 0x1a, 0x8c, 0x82, 0x49, 0x7f, 0xde, 0x45, 0x3c,
+	0xcb, 0xc9, 0x09, 0xf4, 0xbd, 0x54, 0x6b, 0x41, 0x9b, 0xa8, 0x79, 0xef, 0x95, 0x16, 0xe4, 0x39,
+	0x80, 0x17, 0x73, 0x66, 0x19, 0x6d, 0xa1, 0xea, 0xed, 0x18, 0x7b, 0x02, 0x7d, 0xeb, 0x6e, 0x4a,
+	0x44, 0x6e, 0x68, 0x7b, 0xd4, 0x72, 0xb3, 0x48, 0xcc, 0x72, 0xe3, 0x66, 0xbd, 0x58, 0x6b, 0x61,
+	0xe8, 0x11, 0xaa, 0xde, 0x7e, 0xa5, 0xc5, 0x9e, 0x8c, 0xd1, 0x9d, 0x3d, 0x19, 0xa3, 0x1f, 0x43,
+	0xc7, 0x70, 0x99, 0x73, 0x4d, 0xbb, 0x78, 0xeb, 0x16, 0x91, 0x21, 0xf4, 0x34, 0xcf, 0xb8, 0x58,
+	0x71, 0x4d, 0x7b, 0xfe, 0x6b, 0xef, 0x31, 0x21, 0xd0, 0x5e, 0xf2, 0xa5, 0xa2, 0x7d, 0xe4, 0xf1,
+	0x4c, 0xce, 0xe1, 0xc8, 0x6a, 0x96, 0x71, 0x0a, 0xa3, 0xd6, 0x64, 0xf0, 0xfa, 0x65, 0xf4, 0xbf,
+	0x82, 0xa3, 0xa9, 0xaa, 0x2e, 0xda, 0xb7, 0x3f, 0x5f, 0x34, 0xe6, 0x7e, 0x92, 0x8c, 0xe1, 0x38,
+	0x65, 0x86, 0x27, 0xbb, 0x06, 0x07, 0x98, 0x3f, 0x70, 0xe4, 0x3b, 0xdf, 0xe2, 0xf8, 0x2d, 0xb4,
+	0xa6, 0xaa, 0x22, 0x4f, 0xa0, 0x5b, 0x29, 0x6d, 0xff, 0xd6, 0xdc, 0x71, 0x70, 0x96, 0x63, 0x91,
+	0x05, 0x93, 0x92, 0x97, 0x4e, 0x6b, 0x6e, 0x8b, 0xf4, 0xcc, 0x2c, 0x1f, 0x7f, 0x0f, 0xe0, 0xd9,
+	0xbf, 0xd7, 0x37, 0xe7, 0xa6, 0x2e, 0x2d, 0xa1, 0xd0, 0x5d, 0x71, 0x6d, 0x84, 0x92, 0x18, 0x7c,
+	0x3c, 0xbf, 0x87, 0x07, 0xab, 0x6d, 0x1e, 0xae, 0x76, 0x02, 0x0f, 0xbd, 0x84, 0xff, 0x91, 0x14,
+	0xcc, 0x14, 0xdb, 0x1d, 0x3e, 0x40, 0xfe, 0xd2, 0xd1, 0x53, 0x66, 0x8a, 0x83, 0x56, 0xdb, 0x87,
+	0xad, 0x5e, 0x9c, 0xdf, 0xae, 0xc3, 0xe0, 0x6e, 0x1d, 0x06, 0xbf, 0xd6, 0x61, 0xf0, 0x6d, 0x13,
+	0x36, 0xee, 0x36, 0x61, 0xe3, 0xc7, 0x26, 0x6c, 0x7c, 0x7e, 0xb5, 0x10, 0xb6, 0xa8, 0xd3, 0x28,
+	0x53, 0xcb, 0x38, 0x15, 0x4c, 0x7e, 0x11, 0x9c, 0x09, 0xf7, 0xbe, 0x4f, 0x77, 0xef, 0xdb, 0x7e,
+	0xad, 0xb8, 0x49, 0x3b, 0xf8, 0x48, 0xdf, 0xfc, 0x19, 0x00, 0xed, 0x11, 0x3e, 0xc4, 0x0d, 0x03,
+	0x00, 0x00,
 }
 
 func (m *NonFungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
@@ -194,6 +355,27 @@ func (m *NonFungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.BaseClassId) > 0 {
+		i -= len(m.BaseClassId)
+		copy(dAtA[i:], m.BaseClassId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.BaseClassId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Trace) > 0 {
+		for iNdEx := len(m.Trace) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trace[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -266,6 +448,92 @@ func (m *NonFungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *Hop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NonFungibleTokenPacketResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NonFungibleTokenPacketResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NonFungibleTokenPacketResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClassTraceHash) > 0 {
+		i -= len(m.ClassTraceHash)
+		copy(dAtA[i:], m.ClassTraceHash)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ClassTraceHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -325,6 +593,57 @@ func (m *NonFungibleTokenPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if len(m.Trace) > 0 {
+		for _, e := range m.Trace {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = len(m.BaseClassId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *Hop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *NonFungibleTokenPacketResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPacket(uint64(m.Version))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ClassTraceHash)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -651,6 +970,351 @@ func (m *NonFungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trace = append(m.Trace, Hop{})
+			if err := m.Trace[len(m.Trace)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NonFungibleTokenPacketResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NonFungibleTokenPacketResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NonFungibleTokenPacketResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassTraceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassTraceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"reflect"
 	"testing"
 )
 
@@ -14,82 +15,107 @@ func TestNonFungibleTokenPacketData_ValidateBasic(t *testing.T) {
 	}{
 		{
 			name:    "valid packet",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{"kitty_uri"}, tokenData, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{"kitty_uri"}, tokenData, sender, receiver, "memo", nil, ""},
 			wantErr: false,
 		},
 		{
 			name:    "invalid packet with empty classID",
-			packet:  NonFungibleTokenPacketData{"", "uri", "", []string{"kitty"}, []string{"kitty_uri"}, tokenData, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"", "uri", "", []string{"kitty"}, []string{"kitty_uri"}, tokenData, sender, receiver, "memo", nil, ""},
 			wantErr: true,
 		},
 		{
 			name:    "invalid packet with empty tokenIds",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{}, []string{"kitty_uri"}, tokenData, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{}, []string{"kitty_uri"}, tokenData, sender, receiver, "memo", nil, ""},
 			wantErr: true,
 		},
 		{
 			name:    "invalid packet with repeated tokenIds",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty", "kitty"}, []string{"kitty_uri", "kitty_uri"}, tokenData, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty", "kitty"}, []string{"kitty_uri", "kitty_uri"}, tokenData, sender, receiver, "memo", nil, ""},
 			wantErr: true,
 		},
 		{
 			name:    "valid packet with empty tokenUris",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, tokenData, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, tokenData, sender, receiver, "memo", nil, ""},
 			wantErr: false,
 		},
 		{
 			name:    "valid packet with nil tokenUris",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, nil, tokenData, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, nil, tokenData, sender, receiver, "memo", nil, ""},
 			wantErr: false,
 		},
 		{
 			name:    "valid packet with tokenUris",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{"1"}, tokenData, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{"1"}, tokenData, sender, receiver, "memo", nil, ""},
 			wantErr: false,
 		},
 		{
 			name:    "valid packet with tokenUris of empty string entry",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty", "mary"}, []string{"1", ""}, tokenData, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty", "mary"}, []string{"1", ""}, tokenData, sender, receiver, "memo", nil, ""},
 			wantErr: false,
 		},
 		{
 			name:    "invalid packet with unmatched tokenUris number",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{"1", "2"}, tokenData, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{"1", "2"}, tokenData, sender, receiver, "memo", nil, ""},
 			wantErr: true,
 		},
 		{
 			name:    "valid packet with empty tokenData",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, []string{}, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, []string{}, sender, receiver, "memo", nil, ""},
 			wantErr: false,
 		},
 		{
 			name:    "valid packet with nil tokenData",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, nil, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, nil, sender, receiver, "memo", nil, ""},
 			wantErr: false,
 		},
 		{
 			name:    "valid packet with tokenData",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, []string{"1"}, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, []string{"1"}, sender, receiver, "memo", nil, ""},
 			wantErr: false,
 		},
 		{
 			name:    "valid packet with tokenData of empty string entry",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty", "mary"}, []string{}, []string{"1", ""}, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty", "mary"}, []string{}, []string{"1", ""}, sender, receiver, "memo", nil, ""},
 			wantErr: false,
 		},
 		{
 			name:    "invalid packet with unmatched tokenData number",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, []string{"1", "2"}, sender, receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, []string{"1", "2"}, sender, receiver, "memo", nil, ""},
+			wantErr: true,
+		},
+		{
+			name:    "valid packet with class trace",
+			packet:  NonFungibleTokenPacketData{"port-2/channel-2/cat/channel-1", "uri", "", []string{"kitty"}, []string{}, tokenData, sender, receiver, "memo", []Hop{{"port-2", "channel-2"}}, "cat/channel-1"},
+			wantErr: false,
+		},
+		{
+			name:    "valid packet with empty class trace",
+			packet:  NonFungibleTokenPacketData{"cat/channel-1", "uri", "", []string{"kitty"}, []string{}, tokenData, sender, receiver, "memo", nil, "cat/channel-1"},
+			wantErr: false,
+		},
+		{
+			name:    "invalid packet with class trace not matching classID",
+			packet:  NonFungibleTokenPacketData{"port-2/channel-2/cryptoCat", "uri", "", []string{"kitty"}, []string{}, tokenData, sender, receiver, "memo", []Hop{{"port-4", "channel-4"}}, "cryptoCat"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid packet with invalid class trace hop",
+			packet:  NonFungibleTokenPacketData{"port-2/x/cryptoCat", "uri", "", []string{"kitty"}, []string{}, tokenData, sender, receiver, "memo", []Hop{{"port-2", "x"}}, "cryptoCat"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid packet with class trace and empty base classID",
+			packet:  NonFungibleTokenPacketData{"port-2/channel-2/cryptoCat", "uri", "", []string{"kitty"}, []string{}, tokenData, sender, receiver, "memo", []Hop{{"port-2", "channel-2"}}, ""},
 			wantErr: true,
 		},
 		{
 			name:    "invalid packet with empty sender",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, tokenData, "", receiver, "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, tokenData, "", receiver, "memo", nil, ""},
 			wantErr: true,
 		},
 		{
 			name:    "invalid packet with empty receiver",
-			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, tokenData, sender, "", "memo"},
+			packet:  NonFungibleTokenPacketData{"cryptoCat", "uri", "", []string{"kitty"}, []string{}, tokenData, sender, "", "memo", nil, ""},
 			wantErr: true,
 		},
 	}
@@ -175,3 +201,150 @@ func TestNonFungibleTokenPacketData_GetBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestNonFungibleTokenPacketData_GetCustomPacketData(t *testing.T) {
+	tests := []struct {
+		name string
+		memo string
+		key  string
+		want interface{}
+	}{
+		{"empty memo", "", "src_callback", nil},
+		{"memo is not json", "memo", "src_callback", nil},
+		{"key not found", `{"dest_callback":{"address":"contract"}}`, "src_callback", nil},
+		{
+			"src_callback",
+			`{"src_callback":{"address":"contract","gas_limit":"200000"}}`,
+			"src_callback",
+			map[string]interface{}{"address": "contract", "gas_limit": "200000"},
+		},
+		{
+			"dest_callback",
+			`{"src_callback":{"address":"src"},"dest_callback":{"address":"dest"}}`,
+			"dest_callback",
+			map[string]interface{}{"address": "dest"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nftpd := NonFungibleTokenPacketData{ClassId: "cryptoCat", TokenIds: []string{"kitty"}, Sender: sender, Receiver: receiver, Memo: tt.memo}
+			if got := nftpd.GetCustomPacketData(tt.key); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NonFungibleTokenPacketData.GetCustomPacketData() = %v, want %v", got, tt.want)
+			}
+			if got := nftpd.GetPacketSender("nft-transfer"); got != sender {
+				t.Errorf("NonFungibleTokenPacketData.GetPacketSender() = %v, want %v", got, sender)
+			}
+		})
+	}
+}
+
+func TestNonFungibleTokenPacketData_Split(t *testing.T) {
+	nftpd := NonFungibleTokenPacketData{
+		ClassId:   "cryptoCat",
+		ClassUri:  "uri",
+		TokenIds:  []string{"kitty1", "kitty2", "kitty3", "kitty4", "kitty5"},
+		TokenUris: []string{"kitty_uri1", "kitty_uri2", "kitty_uri3", "kitty_uri4", "kitty_uri5"},
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      "memo",
+	}
+	twoTokens := uint64(len(NonFungibleTokenPacketData{
+		ClassId:   nftpd.ClassId,
+		ClassUri:  nftpd.ClassUri,
+		TokenIds:  nftpd.TokenIds[:2],
+		TokenUris: nftpd.TokenUris[:2],
+		Sender:    nftpd.Sender,
+		Receiver:  nftpd.Receiver,
+		Memo:      nftpd.Memo,
+	}.GetBytes()))
+
+	tests := []struct {
+		name      string
+		maxTokens uint64
+		maxBytes  uint64
+		want      [][]string
+		wantErr   bool
+	}{
+		{"no limits", 0, 0, [][]string{nftpd.TokenIds}, false},
+		{"within limits", 5, uint64(len(nftpd.GetBytes())), [][]string{nftpd.TokenIds}, false},
+		{"max tokens", 2, 0, [][]string{{"kitty1", "kitty2"}, {"kitty3", "kitty4"}, {"kitty5"}}, false},
+		{"max bytes", 0, twoTokens, [][]string{{"kitty1", "kitty2"}, {"kitty3", "kitty4"}, {"kitty5"}}, false},
+		{"max bytes below two tokens", 0, twoTokens - 1, [][]string{{"kitty1"}, {"kitty2"}, {"kitty3"}, {"kitty4"}, {"kitty5"}}, false},
+		{"max tokens below max bytes", 1, twoTokens, [][]string{{"kitty1"}, {"kitty2"}, {"kitty3"}, {"kitty4"}, {"kitty5"}}, false},
+		{"single token too large", 0, 10, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packets, err := nftpd.Split(tt.maxTokens, tt.maxBytes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NonFungibleTokenPacketData.Split() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var got [][]string
+			for _, packet := range packets {
+				got = append(got, packet.TokenIds)
+				if tt.maxBytes != 0 && uint64(len(packet.GetBytes())) > tt.maxBytes {
+					t.Errorf("NonFungibleTokenPacketData.Split() packet of %d bytes, max %d", len(packet.GetBytes()), tt.maxBytes)
+				}
+				for i, tokenID := range packet.TokenIds {
+					if want := "kitty_uri" + tokenID[len("kitty"):]; packet.TokenUris[i] != want {
+						t.Errorf("NonFungibleTokenPacketData.Split() token uri = %v, want %v", packet.TokenUris[i], want)
+					}
+				}
+				if packet.ClassId != nftpd.ClassId || packet.ClassUri != nftpd.ClassUri ||
+					packet.Sender != nftpd.Sender || packet.Receiver != nftpd.Receiver || packet.Memo != nftpd.Memo {
+					t.Errorf("NonFungibleTokenPacketData.Split() packet = %v, want the fields of %v", packet, nftpd)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NonFungibleTokenPacketData.Split() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNonFungibleTokenPacketData_SplitEscaping(t *testing.T) {
+	nftpd := NonFungibleTokenPacketData{
+		ClassId:   "cryptoCat",
+		TokenIds:  []string{"kitty1", "kitty2", "kitty3", "kitty4", "kitty5"},
+		TokenUris: []string{"uri?a=1&b=<2>", "", "uri\\path", "", "uri "},
+		TokenData: []string{`{"name":"kitty1"}`, "", "line\nbreak\ttab", `"quoted"`, "é<&>"},
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      `{"note":"<b>\"escaped\" & \\  </b>"}`,
+	}
+	single := 0
+	for i := range nftpd.TokenIds {
+		single = max(single, len(nftpd.slice(i, i+1).GetBytes()))
+	}
+
+	for maxBytes := uint64(single); maxBytes <= uint64(len(nftpd.GetBytes())); maxBytes++ {
+		packets, err := nftpd.Split(0, maxBytes)
+		if err != nil {
+			t.Fatalf("NonFungibleTokenPacketData.Split() max %d bytes error = %v", maxBytes, err)
+		}
+
+		start := 0
+		for _, packet := range packets {
+			if len(packet.GetBytes()) > int(maxBytes) {
+				t.Errorf("NonFungibleTokenPacketData.Split() packet of %d bytes, max %d", len(packet.GetBytes()), maxBytes)
+			}
+			end := start + len(packet.TokenIds)
+			if want := nftpd.slice(start, end); !reflect.DeepEqual(packet, want) {
+				t.Errorf("NonFungibleTokenPacketData.Split() packet = %v, want %v", packet, want)
+			}
+			// the packet is only closed before a token which does not fit
+			if end < len(nftpd.TokenIds) && len(nftpd.slice(start, end+1).GetBytes()) <= int(maxBytes) {
+				t.Errorf("NonFungibleTokenPacketData.Split() packet of tokens %d to %d fits one more token in %d bytes", start, end, maxBytes)
+			}
+			start = end
+		}
+		if start != len(nftpd.TokenIds) {
+			t.Errorf("NonFungibleTokenPacketData.Split() split %d tokens, want %d", start, len(nftpd.TokenIds))
+		}
+	}
+
+	if _, err := nftpd.Split(0, uint64(single)-1); err == nil {
+		t.Errorf("NonFungibleTokenPacketData.Split() max %d bytes expected error", single-1)
+	}
+}
@@ -1,5 +1,17 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
 const (
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
@@ -19,3 +31,126 @@ func NewParams(enableSend, enableReceive bool) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
 }
+
+// NewChannelParams creates a new ChannelParams instance
+func NewChannelParams(portID, channelID string, enableSend, enableReceive bool) ChannelParams {
+	return ChannelParams{
+		PortId:         portID,
+		ChannelId:      channelID,
+		SendEnabled:    enableSend,
+		ReceiveEnabled: enableReceive,
+	}
+}
+
+// Validate performs a basic validation of the parameters
+func (p Params) Validate() error {
+	seen := make(map[string]bool)
+	for _, cp := range p.ChannelParams {
+		if err := cp.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%s", cp.PortId, cp.ChannelId)
+		if seen[key] {
+			return fmt.Errorf("duplicate channel params for %s", key)
+		}
+		seen[key] = true
+	}
+
+	for _, list := range []struct {
+		name    string
+		classes []string
+	}{
+		{"send allowed classes", p.SendAllowedClasses},
+		{"send denied classes", p.SendDeniedClasses},
+		{"receive allowed classes", p.ReceiveAllowedClasses},
+		{"receive denied classes", p.ReceiveDeniedClasses},
+	} {
+		if err := validateClassList(list.classes); err != nil {
+			return errorsmod.Wrapf(err, "invalid %s", list.name)
+		}
+	}
+
+	if p.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Guardian); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid guardian address: %s", err)
+		}
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the channel parameters
+func (cp ChannelParams) Validate() error {
+	if err := host.PortIdentifierValidator(cp.PortId); err != nil {
+		return err
+	}
+	return host.ChannelIdentifierValidator(cp.ChannelId)
+}
+
+// EffectiveChannelParams returns the effective parameters of the given channel. A
+// transfer over the channel is only enabled if it is enabled both globally and
+// for the channel.
+func (p Params) EffectiveChannelParams(portID, channelID string) ChannelParams {
+	channelParams := NewChannelParams(portID, channelID, p.SendEnabled, p.ReceiveEnabled)
+	for _, cp := range p.ChannelParams {
+		if cp.PortId == portID && cp.ChannelId == channelID {
+			channelParams.SendEnabled = channelParams.SendEnabled && cp.SendEnabled
+			channelParams.ReceiveEnabled = channelParams.ReceiveEnabled && cp.ReceiveEnabled
+			break
+		}
+	}
+	return channelParams
+}
+
+// ValidateSendClass checks that the class identified by its local class id and
+// its full class path may be sent from this chain.
+func (p Params) ValidateSendClass(classID, fullClassPath string) error {
+	if !isClassAllowed(p.SendAllowedClasses, p.SendDeniedClasses, classID, fullClassPath) {
+		return errorsmod.Wrapf(ErrClassSendDisabled, "class %s", fullClassPath)
+	}
+	return nil
+}
+
+// ValidateReceiveClass checks that the class identified by its local class id and
+// its full class path may be received by this chain.
+func (p Params) ValidateReceiveClass(classID, fullClassPath string) error {
+	if !isClassAllowed(p.ReceiveAllowedClasses, p.ReceiveDeniedClasses, classID, fullClassPath) {
+		return errorsmod.Wrapf(ErrClassReceiveDisabled, "class %s", fullClassPath)
+	}
+	return nil
+}
+
+// isClassAllowed reports whether a class is not denied and, if an allow list is
+// set, allowed. A class is listed by its base class id, its full class path or
+// its local class id.
+func isClassAllowed(allowed, denied []string, classID, fullClassPath string) bool {
+	ids := []string{classID, fullClassPath, ParseClassTrace(fullClassPath).BaseClassId}
+	if isClassListed(denied, ids) {
+		return false
+	}
+	return len(allowed) == 0 || isClassListed(allowed, ids)
+}
+
+func isClassListed(list []string, ids []string) bool {
+	for _, entry := range list {
+		for _, id := range ids {
+			if entry == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func validateClassList(classes []string) error {
+	seen := make(map[string]bool)
+	for _, class := range classes {
+		if strings.TrimSpace(class) == "" {
+			return errorsmod.Wrap(ErrInvalidClassID, "classId cannot be blank")
+		}
+		if seen[class] {
+			return errorsmod.Wrapf(ErrInvalidClassID, "duplicate classId %s", class)
+		}
+		seen[class] = true
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"testing"
+)
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  Params
+		wantErr bool
+	}{
+		{"default", DefaultParams(), false},
+		{
+			"valid channel and class params",
+			Params{
+				ChannelParams:         []ChannelParams{NewChannelParams(PortID, "channel-0", false, true)},
+				SendAllowedClasses:    []string{"kitty"},
+				ReceiveDeniedClasses:  []string{"nft-transfer/channel-0/kitty"},
+				ReceiveAllowedClasses: []string{"ibc/1A2B"},
+			},
+			false,
+		},
+		{"invalid port", Params{ChannelParams: []ChannelParams{NewChannelParams("(port)", "channel-0", false, true)}}, true},
+		{
+			"duplicate channel",
+			Params{ChannelParams: []ChannelParams{
+				NewChannelParams(PortID, "channel-0", false, true),
+				NewChannelParams(PortID, "channel-0", true, false),
+			}},
+			true,
+		},
+		{"blank class", Params{SendDeniedClasses: []string{" "}}, true},
+		{"duplicate class", Params{ReceiveAllowedClasses: []string{"kitty", "kitty"}}, true},
+		{"valid guardian", Params{Guardian: sender}, false},
+		{"invalid guardian", Params{Guardian: "guardian"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Params.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParams_EffectiveChannelParams(t *testing.T) {
+	params := Params{
+		SendEnabled:    true,
+		ReceiveEnabled: false,
+		ChannelParams:  []ChannelParams{NewChannelParams(PortID, "channel-0", false, true)},
+	}
+	tests := []struct {
+		name      string
+		channelID string
+		want      ChannelParams
+	}{
+		{"channel with params", "channel-0", NewChannelParams(PortID, "channel-0", false, false)},
+		{"channel without params", "channel-1", NewChannelParams(PortID, "channel-1", true, false)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := params.EffectiveChannelParams(PortID, tt.channelID); got != tt.want {
+				t.Errorf("Params.EffectiveChannelParams() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParams_ValidateSendClass(t *testing.T) {
+	trace := ParseClassTrace("nft-transfer/channel-0/kitty")
+	tests := []struct {
+		name    string
+		params  Params
+		wantErr bool
+	}{
+		{"no lists", Params{}, false},
+		{"allowed by base class", Params{SendAllowedClasses: []string{"kitty"}}, false},
+		{"allowed by class path", Params{SendAllowedClasses: []string{trace.GetFullClassPath()}}, false},
+		{"allowed by local class", Params{SendAllowedClasses: []string{trace.IBCClassID()}}, false},
+		{"not allowed", Params{SendAllowedClasses: []string{"doggy"}}, true},
+		{"denied by local class", Params{SendDeniedClasses: []string{trace.IBCClassID()}}, true},
+		{"denied takes precedence", Params{SendAllowedClasses: []string{"kitty"}, SendDeniedClasses: []string{"kitty"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.ValidateSendClass(trace.IBCClassID(), trace.GetFullClassPath()); (err != nil) != tt.wantErr {
+				t.Errorf("Params.ValidateSendClass() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// RecvPacketTypeURL is the type URL under which the x/circuit breaker may trip
+// the receipt of nft-transfer packets, as MsgTransfer is tripped under its own
+// type URL.
+var RecvPacketTypeURL = sdk.MsgTypeURL(&NonFungibleTokenPacketData{})
+
+// NewPause creates a new Pause instance
+func NewPause(portID, channelID, classID string) Pause {
+	return Pause{
+		PortId:    portID,
+		ChannelId: channelID,
+		ClassId:   classID,
+	}
+}
+
+// Validate performs a basic validation of the Pause fields
+func (p Pause) Validate() error {
+	switch {
+	case p.PortId == "" && p.ChannelId == "":
+		if p.ClassId == "" {
+			return errorsmod.Wrap(ErrInvalidPause, "either a channel or a class must be paused")
+		}
+	case p.PortId == "" || p.ChannelId == "":
+		return errorsmod.Wrap(ErrInvalidPause, "port and channel must be both set or both empty")
+	default:
+		if err := host.PortIdentifierValidator(p.PortId); err != nil {
+			return err
+		}
+		if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+			return err
+		}
+	}
+
+	if p.ClassId != "" && strings.TrimSpace(p.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "classId cannot be blank")
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewRateLimit creates a new RateLimit instance
+func NewRateLimit(
+	portID, channelID, classID string,
+	maxSend, maxReceive uint64,
+	window time.Duration,
+) RateLimit {
+	return RateLimit{
+		PortId:     portID,
+		ChannelId:  channelID,
+		ClassId:    classID,
+		MaxSend:    maxSend,
+		MaxReceive: maxReceive,
+		Window:     window,
+	}
+}
+
+// Validate performs a basic validation of the RateLimit fields
+func (rl RateLimit) Validate() error {
+	if err := validateRateLimitPath(rl.PortId, rl.ChannelId, rl.ClassId); err != nil {
+		return err
+	}
+	if rl.Window <= 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "window must be positive")
+	}
+	return nil
+}
+
+// NewRateLimitFlow creates a new RateLimitFlow instance whose window starts at
+// the given time
+func NewRateLimitFlow(portID, channelID, classID string, windowStart time.Time) RateLimitFlow {
+	return RateLimitFlow{
+		PortId:      portID,
+		ChannelId:   channelID,
+		ClassId:     classID,
+		WindowStart: windowStart,
+	}
+}
+
+// Validate performs a basic validation of the RateLimitFlow fields
+func (f RateLimitFlow) Validate() error {
+	return validateRateLimitPath(f.PortId, f.ChannelId, f.ClassId)
+}
+
+// IsExpired returns true if the window of the flow has ended at the given time
+func (f RateLimitFlow) IsExpired(rl RateLimit, now time.Time) bool {
+	return !now.Before(f.WindowStart.Add(rl.Window))
+}
+
+// Advance returns the flow of the window containing the given time. If the
+// window of the flow has ended, the tokens it counted become those of the
+// previous window, unless a whole window has passed since.
+func (f RateLimitFlow) Advance(rl RateLimit, now time.Time) RateLimitFlow {
+	if !f.IsExpired(rl, now) {
+		return f
+	}
+
+	nextStart := f.WindowStart.Add(rl.Window)
+	if !now.Before(nextStart.Add(rl.Window)) {
+		return NewRateLimitFlow(f.PortId, f.ChannelId, f.ClassId, now)
+	}
+
+	f.WindowStart = nextStart
+	f.PreviousSent, f.PreviousReceived = f.Sent, f.Received
+	f.Sent, f.Received = 0, 0
+	return f
+}
+
+// AddSent counts the given number of sent tokens against the rate limit at the
+// given time, which must be within the window of the flow. It returns an error
+// if the quota of the rolling window is exceeded.
+func (f *RateLimitFlow) AddSent(rl RateLimit, now time.Time, count uint64) error {
+	sent := f.Sent + f.weightPrevious(rl, now, f.PreviousSent)
+	if rl.MaxSend != 0 && sent+count > rl.MaxSend {
+		return errorsmod.Wrapf(ErrRateLimitExceeded,
+			"sending %d tokens of class %s over channel %s exceeds the quota: sent %d, max %d",
+			count, rl.ClassId, rl.ChannelId, sent, rl.MaxSend)
+	}
+	f.Sent += count
+	return nil
+}
+
+// AddReceived counts the given number of received tokens against the rate limit
+// at the given time, which must be within the window of the flow. It returns an
+// error if the quota of the rolling window is exceeded.
+func (f *RateLimitFlow) AddReceived(rl RateLimit, now time.Time, count uint64) error {
+	received := f.Received + f.weightPrevious(rl, now, f.PreviousReceived)
+	if rl.MaxReceive != 0 && received+count > rl.MaxReceive {
+		return errorsmod.Wrapf(ErrRateLimitExceeded,
+			"receiving %d tokens of class %s over channel %s exceeds the quota: received %d, max %d",
+			count, rl.ClassId, rl.ChannelId, received, rl.MaxReceive)
+	}
+	f.Received += count
+	return nil
+}
+
+// weightPrevious returns the given count of the previous window weighted by the
+// fraction of the previous window overlapped by the rolling window ending at
+// the given time, rounded up.
+func (f RateLimitFlow) weightPrevious(rl RateLimit, now time.Time, count uint64) uint64 {
+	remaining := f.WindowStart.Add(rl.Window).Sub(now)
+	if count == 0 || remaining <= 0 {
+		return 0
+	}
+	window := sdkmath.NewInt(int64(rl.Window))
+	return sdkmath.NewIntFromUint64(count).
+		Mul(sdkmath.NewInt(int64(remaining))).
+		Add(window.SubRaw(1)).
+		Quo(window).
+		Uint64()
+}
+
+// ReleaseSent releases the quota taken by the given number of sent tokens
+// counted in the window starting at the given time, if it is the current or
+// the previous window of the flow.
+func (f *RateLimitFlow) ReleaseSent(rl RateLimit, windowStart time.Time, count uint64) {
+	switch {
+	case windowStart.Equal(f.WindowStart):
+		f.Sent = releaseCount(f.Sent, count)
+	case windowStart.Equal(f.WindowStart.Add(-rl.Window)):
+		f.PreviousSent = releaseCount(f.PreviousSent, count)
+	}
+}
+
+func releaseCount(counted, count uint64) uint64 {
+	if count > counted {
+		return 0
+	}
+	return counted - count
+}
+
+// NewPendingSendPacket creates a new PendingSendPacket instance
+func NewPendingSendPacket(
+	portID, channelID string,
+	sequence uint64,
+	classID string,
+	tokenCount uint64,
+	windowStart time.Time,
+) PendingSendPacket {
+	return PendingSendPacket{
+		PortId:      portID,
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		ClassId:     classID,
+		TokenCount:  tokenCount,
+		WindowStart: windowStart,
+	}
+}
+
+// Validate performs a basic validation of the PendingSendPacket fields
+func (p PendingSendPacket) Validate() error {
+	if err := validateRateLimitPath(p.PortId, p.ChannelId, p.ClassId); err != nil {
+		return err
+	}
+	if p.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "sequence cannot be 0")
+	}
+	return nil
+}
+
+func validateRateLimitPath(portID, channelID, classID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return err
+	}
+	if strings.TrimSpace(classID) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "classId cannot be blank")
+	}
+	return nil
+}
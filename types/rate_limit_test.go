@@ -0,0 +1,103 @@
+package types
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimit_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		rateLimit RateLimit
+		wantErr   bool
+	}{
+		{"valid", NewRateLimit(PortID, "channel-0", "kitty", 10, 5, time.Hour), false},
+		{"unlimited", NewRateLimit(PortID, "channel-0", "ibc/1A2B", 0, 0, time.Hour), false},
+		{"invalid port", NewRateLimit("(port)", "channel-0", "kitty", 10, 5, time.Hour), true},
+		{"invalid channel", NewRateLimit(PortID, "(channel)", "kitty", 10, 5, time.Hour), true},
+		{"blank class", NewRateLimit(PortID, "channel-0", " ", 10, 5, time.Hour), true},
+		{"zero window", NewRateLimit(PortID, "channel-0", "kitty", 10, 5, 0), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rateLimit.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("RateLimit.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRateLimitFlow(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	rateLimit := NewRateLimit(PortID, "channel-0", "kitty", 2, 1, time.Hour)
+	flow := NewRateLimitFlow(PortID, "channel-0", "kitty", now)
+
+	if err := flow.AddSent(rateLimit, now, 2); err != nil {
+		t.Fatalf("AddSent() error = %v", err)
+	}
+	if err := flow.AddSent(rateLimit, now, 1); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("AddSent() error = %v, want %v", err, ErrRateLimitExceeded)
+	}
+	if err := flow.AddReceived(rateLimit, now, 2); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("AddReceived() error = %v, want %v", err, ErrRateLimitExceeded)
+	}
+	if flow.Sent != 2 || flow.Received != 0 {
+		t.Errorf("flow = %d sent %d received, want 2 sent 0 received", flow.Sent, flow.Received)
+	}
+
+	flow.ReleaseSent(rateLimit, now, 3)
+	if flow.Sent != 0 {
+		t.Errorf("ReleaseSent() sent = %d, want 0", flow.Sent)
+	}
+
+	if flow.IsExpired(rateLimit, now.Add(time.Hour-time.Second)) {
+		t.Error("IsExpired() = true before the end of the window")
+	}
+	if !flow.IsExpired(rateLimit, now.Add(time.Hour)) {
+		t.Error("IsExpired() = false at the end of the window")
+	}
+}
+
+func TestRateLimitFlow_RollingWindow(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	rateLimit := NewRateLimit(PortID, "channel-0", "kitty", 10, 0, time.Hour)
+	flow := NewRateLimitFlow(PortID, "channel-0", "kitty", now)
+
+	// the whole quota is taken at the end of the window
+	end := now.Add(time.Hour - time.Second)
+	if err := flow.AddSent(rateLimit, end, 10); err != nil {
+		t.Fatalf("AddSent() error = %v", err)
+	}
+
+	// the burst right after the boundary still counts the previous window
+	flow = flow.Advance(rateLimit, now.Add(time.Hour))
+	if !flow.WindowStart.Equal(now.Add(time.Hour)) || flow.Sent != 0 || flow.PreviousSent != 10 {
+		t.Fatalf("Advance() = %v, want the next window with 10 tokens sent in the previous one", flow)
+	}
+	if err := flow.AddSent(rateLimit, now.Add(time.Hour), 1); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("AddSent() error = %v, want %v", err, ErrRateLimitExceeded)
+	}
+
+	// the previous window is weighted by its overlap with the rolling window
+	half := now.Add(time.Hour + time.Hour/2)
+	if err := flow.AddSent(rateLimit, half, 5); err != nil {
+		t.Errorf("AddSent() error = %v", err)
+	}
+	if err := flow.AddSent(rateLimit, half, 1); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("AddSent() error = %v, want %v", err, ErrRateLimitExceeded)
+	}
+
+	// the tokens of the previous window may be released
+	flow.ReleaseSent(rateLimit, now, 10)
+	if flow.PreviousSent != 0 || flow.Sent != 5 {
+		t.Errorf("ReleaseSent() = %d sent %d previously sent, want 5 sent 0 previously sent", flow.Sent, flow.PreviousSent)
+	}
+
+	// the previous window is dropped once a whole window has passed
+	later := now.Add(3 * time.Hour)
+	flow = flow.Advance(rateLimit, later)
+	if !flow.WindowStart.Equal(later) || flow.Sent != 0 || flow.PreviousSent != 0 {
+		t.Errorf("Advance() = %v, want a new window", flow)
+	}
+}
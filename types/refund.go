@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewRefundRecord creates a new RefundRecord instance
+func NewRefundRecord(portID, channelID string, sequence uint64, refundAddress string) RefundRecord {
+	return RefundRecord{
+		PortId:        portID,
+		ChannelId:     channelID,
+		Sequence:      sequence,
+		RefundAddress: refundAddress,
+	}
+}
+
+// Validate performs a basic validation of the RefundRecord fields
+func (r RefundRecord) Validate() error {
+	if err := host.PortIdentifierValidator(r.PortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return err
+	}
+	if r.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "sequence cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.RefundAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid refund address: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,466 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/nft_transfer/v1/refund.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RefundRecord defines the address refunded the tokens of a packet sent, in
+// place of the sender, on an error acknowledgement or a timeout. It is removed
+// once the packet is acknowledged or timed out.
+type RefundRecord struct {
+	// the port on which the packet was sent
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the channel on which the packet was sent
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the sequence of the packet
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the address refunded the tokens
+	RefundAddress string `protobuf:"bytes,4,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+}
+
+func (m *RefundRecord) Reset()         { *m = RefundRecord{} }
+func (m *RefundRecord) String() string { return proto.CompactTextString(m) }
+func (*RefundRecord) ProtoMessage()    {}
+func (*RefundRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc0a2a84688d1f74, []int{0}
+}
+func (m *RefundRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundRecord.Merge(m, src)
+}
+func (m *RefundRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundRecord proto.InternalMessageInfo
+
+func (m *RefundRecord) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *RefundRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RefundRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *RefundRecord) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RefundRecord)(nil), "ibc.applications.nft_transfer.v1.RefundRecord")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/nft_transfer/v1/refund.proto", fileDescriptor_cc0a2a84688d1f74)
+}
+
+var fileDescriptor_cc0a2a84688d1f74 = []byte{
+	// 246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x8f, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x85, 0x63, 0xa8, 0x0a, 0xb5, 0x80, 0xc1, 0x0b, 0x11, 0x12, 0x56, 0x84, 0x84, 0xe8, 0x52,
+	0x5b, 0x15, 0x4f, 0x50, 0xb6, 0xae, 0x19, 0x59, 0x22, 0xff, 0xdc, 0x50, 0xa3, 0x62, 0x1b, 0xdb,
+	0xa9, 0xc4, 0x23, 0xb0, 0xf1, 0x58, 0x8c, 0x1d, 0x19, 0x51, 0xf2, 0x22, 0x28, 0x09, 0xaa, 0x3a,
+	0xde, 0xef, 0x9c, 0x23, 0xdd, 0x0f, 0x2f, 0x8c, 0x54, 0x5c, 0x78, 0xbf, 0x35, 0x4a, 0x24, 0xe3,
+	0x6c, 0xe4, 0xb6, 0x4e, 0x55, 0x0a, 0xc2, 0xc6, 0x1a, 0x02, 0xdf, 0x2d, 0x79, 0x80, 0xba, 0xb1,
+	0x9a, 0xf9, 0xe0, 0x92, 0x23, 0x85, 0x91, 0x8a, 0x1d, 0xd7, 0xd9, 0x71, 0x9d, 0xed, 0x96, 0x77,
+	0x9f, 0x08, 0x5f, 0x94, 0xc3, 0xa4, 0x04, 0xe5, 0x82, 0x26, 0xd7, 0xf8, 0xcc, 0xbb, 0x90, 0x2a,
+	0xa3, 0x73, 0x54, 0xa0, 0xf9, 0xac, 0x9c, 0xf6, 0xe7, 0x5a, 0x93, 0x5b, 0x8c, 0xd5, 0x46, 0x58,
+	0x0b, 0xdb, 0x3e, 0x3b, 0x19, 0xb2, 0xd9, 0x3f, 0x59, 0x6b, 0x72, 0x83, 0xcf, 0x23, 0xbc, 0x37,
+	0x60, 0x15, 0xe4, 0xa7, 0x05, 0x9a, 0x4f, 0xca, 0xc3, 0x4d, 0xee, 0xf1, 0xd5, 0xf8, 0x56, 0x25,
+	0xb4, 0x0e, 0x10, 0x63, 0x3e, 0x19, 0xe6, 0x97, 0x23, 0x5d, 0x8d, 0xf0, 0x69, 0xf5, 0xdd, 0x52,
+	0xb4, 0x6f, 0x29, 0xfa, 0x6d, 0x29, 0xfa, 0xea, 0x68, 0xb6, 0xef, 0x68, 0xf6, 0xd3, 0xd1, 0xec,
+	0xf9, 0xe1, 0xc5, 0xa4, 0x4d, 0x23, 0x99, 0x72, 0x6f, 0x5c, 0x1a, 0x61, 0x5f, 0x0d, 0x08, 0xd3,
+	0xab, 0x2f, 0x0e, 0xea, 0xe9, 0xc3, 0x43, 0x94, 0xd3, 0xc1, 0xfb, 0xf1, 0x6f, 0x00, 0xf6, 0xe7,
+	0xc5, 0x5f, 0x28, 0x01, 0x00, 0x00,
+}
+
+func (m *RefundRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintRefund(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintRefund(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRefund(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintRefund(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRefund(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRefund(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RefundRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovRefund(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRefund(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovRefund(uint64(m.Sequence))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovRefund(uint64(l))
+	}
+	return n
+}
+
+func sovRefund(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRefund(x uint64) (n int) {
+	return sovRefund(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RefundRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRefund
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRefund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRefund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRefund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRefund(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRefund(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRefund
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRefund
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRefund
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRefund
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRefund        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRefund          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRefund = fmt.Errorf("proto: unexpected end of group")
+)
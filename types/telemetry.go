@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewPacketSendHeight creates a new PacketSendHeight instance
+func NewPacketSendHeight(portID, channelID string, sequence uint64, height int64) PacketSendHeight {
+	return PacketSendHeight{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Height:    height,
+	}
+}
+
+// Validate performs a basic validation of the PacketSendHeight fields
+func (h PacketSendHeight) Validate() error {
+	if err := host.PortIdentifierValidator(h.PortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(h.ChannelId); err != nil {
+		return err
+	}
+	if h.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "sequence cannot be 0")
+	}
+	if h.Height < 0 {
+		return errorsmod.Wrapf(ErrInvalidPacket, "send height cannot be negative: %d", h.Height)
+	}
+	return nil
+}
@@ -0,0 +1,450 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/nft_transfer/v1/telemetry.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PacketSendHeight records the height at which a packet was sent, so that the
+// latency in blocks of its acknowledgement or timeout can be reported. It is
+// removed once the packet is acknowledged or timed out.
+type PacketSendHeight struct {
+	// the port on which the packet was sent
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the channel on which the packet was sent
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the sequence of the packet
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the height at which the packet was sent
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PacketSendHeight) Reset()         { *m = PacketSendHeight{} }
+func (m *PacketSendHeight) String() string { return proto.CompactTextString(m) }
+func (*PacketSendHeight) ProtoMessage()    {}
+func (*PacketSendHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e08593e94ef34d2, []int{0}
+}
+func (m *PacketSendHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketSendHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketSendHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketSendHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketSendHeight.Merge(m, src)
+}
+func (m *PacketSendHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketSendHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketSendHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketSendHeight proto.InternalMessageInfo
+
+func (m *PacketSendHeight) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PacketSendHeight) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketSendHeight) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketSendHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PacketSendHeight)(nil), "ibc.applications.nft_transfer.v1.PacketSendHeight")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/nft_transfer/v1/telemetry.proto", fileDescriptor_7e08593e94ef34d2)
+}
+
+var fileDescriptor_7e08593e94ef34d2 = []byte{
+	// 247 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x8f, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x63, 0x5a, 0x05, 0xea, 0x09, 0x65, 0x80, 0x08, 0x09, 0x2b, 0x62, 0x21, 0x0b, 0x09,
+	0x15, 0x4f, 0x00, 0x13, 0xdd, 0x50, 0xd8, 0x58, 0x2a, 0xdb, 0xb9, 0x36, 0x07, 0xa9, 0x6d, 0x9c,
+	0x6b, 0xa5, 0x2e, 0x3c, 0x03, 0x8f, 0xc5, 0xd8, 0x91, 0x11, 0x25, 0x2f, 0x82, 0x12, 0x55, 0x55,
+	0xc7, 0xef, 0x7e, 0x7d, 0x27, 0x7d, 0xfc, 0x1e, 0x95, 0xce, 0xa5, 0x73, 0x35, 0x6a, 0x49, 0x68,
+	0x4d, 0x93, 0x9b, 0x05, 0xcd, 0xc9, 0x4b, 0xd3, 0x2c, 0xc0, 0xe7, 0x9b, 0x69, 0x4e, 0x50, 0xc3,
+	0x0a, 0xc8, 0x6f, 0x33, 0xe7, 0x2d, 0xd9, 0x28, 0x41, 0xa5, 0xb3, 0x63, 0x23, 0x3b, 0x36, 0xb2,
+	0xcd, 0xf4, 0xe6, 0x8b, 0x9f, 0xbf, 0x48, 0xfd, 0x01, 0xf4, 0x0a, 0xa6, 0x7c, 0x06, 0x5c, 0x56,
+	0x14, 0x5d, 0xf2, 0x53, 0x67, 0x3d, 0xcd, 0xb1, 0x8c, 0x59, 0xc2, 0xd2, 0x49, 0x11, 0xf6, 0x38,
+	0x2b, 0xa3, 0x6b, 0xce, 0x75, 0x25, 0x8d, 0x81, 0xba, 0xdf, 0x4e, 0x86, 0x6d, 0xb2, 0xbf, 0xcc,
+	0xca, 0xe8, 0x8a, 0x9f, 0x35, 0xf0, 0xb9, 0x06, 0xa3, 0x21, 0x1e, 0x25, 0x2c, 0x1d, 0x17, 0x07,
+	0x8e, 0x2e, 0x78, 0x58, 0x0d, 0xdf, 0xe3, 0x71, 0xc2, 0xd2, 0x51, 0xb1, 0xa7, 0xa7, 0xc7, 0x9f,
+	0x56, 0xb0, 0x5d, 0x2b, 0xd8, 0x5f, 0x2b, 0xd8, 0x77, 0x27, 0x82, 0x5d, 0x27, 0x82, 0xdf, 0x4e,
+	0x04, 0x6f, 0xb7, 0x4b, 0xa4, 0x6a, 0xad, 0x32, 0x6d, 0x57, 0xb9, 0x42, 0x69, 0xde, 0x11, 0x24,
+	0xf6, 0xc5, 0x77, 0x87, 0x62, 0xda, 0x3a, 0x68, 0x54, 0x38, 0xb4, 0x3e, 0xfc, 0x0f, 0x00, 0xf7,
+	0x86, 0x79, 0x4b, 0x1f, 0x01, 0x00, 0x00,
+}
+
+func (m *PacketSendHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketSendHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketSendHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTelemetry(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTelemetry(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTelemetry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTelemetry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PacketSendHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTelemetry(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTelemetry(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTelemetry(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTelemetry(uint64(m.Height))
+	}
+	return n
+}
+
+func sovTelemetry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTelemetry(x uint64) (n int) {
+	return sovTelemetry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PacketSendHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketSendHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketSendHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTelemetry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTelemetry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTelemetry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTelemetry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTelemetry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTelemetry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTelemetry = fmt.Errorf("proto: unexpected end of group")
+)
@@ -81,6 +81,52 @@ func ParseClassTrace(rawClassID string) ClassTrace {
 	}
 }
 
+// NewClassTraceFromHops returns the class trace of the given base classID
+// whose path is made of the given hops.
+func NewClassTraceFromHops(hops []Hop, baseClassID string) ClassTrace {
+	identifiers := make([]string, 0, 2*len(hops))
+	for _, hop := range hops {
+		identifiers = append(identifiers, hop.PortId, hop.ChannelId)
+	}
+	return ClassTrace{
+		Path:        strings.Join(identifiers, "/"),
+		BaseClassId: baseClassID,
+	}
+}
+
+// GetHops returns the port and channel pairs making up the trace path.
+func (ct ClassTrace) GetHops() []Hop {
+	if ct.Path == "" {
+		return nil
+	}
+
+	identifiers := strings.Split(ct.Path, "/")
+	hops := make([]Hop, 0, len(identifiers)/2)
+	for i := 0; i+1 < len(identifiers); i += 2 {
+		hops = append(hops, Hop{PortId: identifiers[i], ChannelId: identifiers[i+1]})
+	}
+	return hops
+}
+
+// AddPrefix returns the class trace with the given port and channel prepended
+// to its path, as recorded by the chain receiving the class over them.
+func (ct ClassTrace) AddPrefix(portID, channelID string) ClassTrace {
+	return NewClassTraceFromHops(
+		append([]Hop{{PortId: portID, ChannelId: channelID}}, ct.GetHops()...),
+		ct.BaseClassId,
+	)
+}
+
+// RemovePrefix returns the class trace with the given port and channel removed
+// from the start of its path, undoing AddPrefix.
+func (ct ClassTrace) RemovePrefix(portID, channelID string) (ClassTrace, error) {
+	hops := ct.GetHops()
+	if len(hops) == 0 || hops[0].PortId != portID || hops[0].ChannelId != channelID {
+		return ClassTrace{}, fmt.Errorf("invalid class trace:%s, no class prefix: %s", ct.GetFullClassPath(), GetClassPrefix(portID, channelID))
+	}
+	return NewClassTraceFromHops(hops[1:], ct.BaseClassId), nil
+}
+
 // GetFullClassPath returns the full classId according to the ICS721 specification:
 // tracePath + "/" + BaseClassId
 // If there exists no trace then the base BaseClassId is returned.
@@ -196,8 +242,8 @@ func extractPathAndBaseFromFullClassID(fullClassIdItems []string) (string, strin
 		// The IBC specification does not guarantee the expected format of the
 		// destination port or destination channel identifier. A short term solution
 		// to determine base classID is to expect the channel identifier to be the
-		// one ibc-go specifies. A longer term solution is to separate the path and base
-		// denomination in the ICS721 packet.
+		// one ibc-go specifies. Packets sent over ics721-2 channels carry the path
+		// and base classID separately and do not rely on this.
 		if i < length-1 && length > 2 && channeltypes.IsValidChannelID(fullClassIdItems[i+1]) {
 			pathSlice = append(pathSlice, fullClassIdItems[i], fullClassIdItems[i+1])
 		} else {
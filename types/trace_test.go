@@ -78,3 +78,34 @@ func TestClassTrace_GetFullClassPath(t *testing.T) {
 		})
 	}
 }
+
+func TestClassTrace_AddAndRemovePrefix(t *testing.T) {
+	tests := []struct {
+		name string
+		ct   ClassTrace
+		want ClassTrace
+	}{
+		{"native class", ClassTrace{Path: "", BaseClassId: "kitty"}, ClassTrace{Path: "port-2/channel-2", BaseClassId: "kitty"}},
+		{"native class with channel", ClassTrace{Path: "", BaseClassId: "cat/channel-1"}, ClassTrace{Path: "port-2/channel-2", BaseClassId: "cat/channel-1"}},
+		{"first  tranfer", ClassTrace{Path: "port-4/channel-4", BaseClassId: "kitty"}, ClassTrace{Path: "port-2/channel-2/port-4/channel-4", BaseClassId: "kitty"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.ct.AddPrefix("port-2", "channel-2")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ClassTrace.AddPrefix() = %v, want %v", got, tt.want)
+			}
+			if got := NewClassTraceFromHops(got.GetHops(), got.BaseClassId); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewClassTraceFromHops() = %v, want %v", got, tt.want)
+			}
+
+			removed, err := got.RemovePrefix("port-2", "channel-2")
+			if err != nil || !reflect.DeepEqual(removed, tt.ct) {
+				t.Errorf("ClassTrace.RemovePrefix() = %v, %v, want %v", removed, err, tt.ct)
+			}
+			if _, err := got.RemovePrefix("port-4", "channel-4"); err == nil {
+				t.Errorf("ClassTrace.RemovePrefix() expected error for port-4/channel-4")
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -89,6 +90,34 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain nft transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+	// channel_params overrides send_enabled and receive_enabled for individual
+	// channels. A channel without an entry follows the global switches.
+	ChannelParams []ChannelParams `protobuf:"bytes,3,rep,name=channel_params,json=channelParams,proto3" json:"channel_params"`
+	// send_allowed_classes restricts the classes which may be sent from this
+	// chain. If it is empty, all classes may be sent. A class is listed by its
+	// base class id, its full class path or its local class id (ibc/{hash}).
+	SendAllowedClasses []string `protobuf:"bytes,4,rep,name=send_allowed_classes,json=sendAllowedClasses,proto3" json:"send_allowed_classes,omitempty"`
+	// send_denied_classes lists the classes which may not be sent from this
+	// chain.
+	SendDeniedClasses []string `protobuf:"bytes,5,rep,name=send_denied_classes,json=sendDeniedClasses,proto3" json:"send_denied_classes,omitempty"`
+	// receive_allowed_classes restricts the classes which may be received by
+	// this chain. If it is empty, all classes may be received.
+	ReceiveAllowedClasses []string `protobuf:"bytes,6,rep,name=receive_allowed_classes,json=receiveAllowedClasses,proto3" json:"receive_allowed_classes,omitempty"`
+	// receive_denied_classes lists the classes which may not be received by
+	// this chain.
+	ReceiveDeniedClasses []string `protobuf:"bytes,7,rep,name=receive_denied_classes,json=receiveDeniedClasses,proto3" json:"receive_denied_classes,omitempty"`
+	// guardian is the address which may pause and unpause the transfers over a
+	// channel or of a class besides the authority. Nobody but the authority may
+	// if it is empty.
+	Guardian string `protobuf:"bytes,8,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// max_tokens_per_packet is the maximum number of tokens sent in a single
+	// packet. The tokens of a larger transfer are split into several packets.
+	// There is no limit if it is 0.
+	MaxTokensPerPacket uint64 `protobuf:"varint,9,opt,name=max_tokens_per_packet,json=maxTokensPerPacket,proto3" json:"max_tokens_per_packet,omitempty"`
+	// max_packet_bytes is the maximum size in bytes of the data of a single
+	// packet. The tokens of a larger transfer are split into several packets.
+	// There is no limit if it is 0.
+	MaxPacketBytes uint64 `protobuf:"varint,10,opt,name=max_packet_bytes,json=maxPacketBytes,proto3" json:"max_packet_bytes,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -138,9 +167,141 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+func (m *Params) GetChannelParams() []ChannelParams {
+	if m != nil {
+		return m.ChannelParams
+	}
+	return nil
+}
+
+func (m *Params) GetSendAllowedClasses() []string {
+	if m != nil {
+		return m.SendAllowedClasses
+	}
+	return nil
+}
+
+func (m *Params) GetSendDeniedClasses() []string {
+	if m != nil {
+		return m.SendDeniedClasses
+	}
+	return nil
+}
+
+func (m *Params) GetReceiveAllowedClasses() []string {
+	if m != nil {
+		return m.ReceiveAllowedClasses
+	}
+	return nil
+}
+
+func (m *Params) GetReceiveDeniedClasses() []string {
+	if m != nil {
+		return m.ReceiveDeniedClasses
+	}
+	return nil
+}
+
+func (m *Params) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *Params) GetMaxTokensPerPacket() uint64 {
+	if m != nil {
+		return m.MaxTokensPerPacket
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPacketBytes() uint64 {
+	if m != nil {
+		return m.MaxPacketBytes
+	}
+	return 0
+}
+
+// ChannelParams defines the nft-transfer parameters of a single channel.
+type ChannelParams struct {
+	// the port on which the parameters apply
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the channel on which the parameters apply
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// send_enabled enables or disables cross-chain nft transfers over this
+	// channel from this chain.
+	SendEnabled bool `protobuf:"varint,3,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// receive_enabled enables or disables cross-chain nft transfers over this
+	// channel to this chain.
+	ReceiveEnabled bool `protobuf:"varint,4,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+}
+
+func (m *ChannelParams) Reset()         { *m = ChannelParams{} }
+func (m *ChannelParams) String() string { return proto.CompactTextString(m) }
+func (*ChannelParams) ProtoMessage()    {}
+func (*ChannelParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbbec0a5a50746a6, []int{2}
+}
+func (m *ChannelParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelParams.Merge(m, src)
+}
+func (m *ChannelParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelParams proto.InternalMessageInfo
+
+func (m *ChannelParams) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelParams) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelParams) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *ChannelParams) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ClassTrace)(nil), "ibc.applications.nft_transfer.v1.ClassTrace")
 	proto.RegisterType((*Params)(nil), "ibc.applications.nft_transfer.v1.Params")
+	proto.RegisterType((*ChannelParams)(nil), "ibc.applications.nft_transfer.v1.ChannelParams")
 }
 
 func init() {
@@ -148,23 +309,39 @@ func init() {
 }
 
 var fileDescriptor_fbbec0a5a50746a6 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
-	0x14, 0x85, 0x9b, 0x0a, 0x55, 0xe0, 0xf2, 0x23, 0x79, 0xea, 0x64, 0x95, 0x2c, 0x65, 0xc1, 0x56,
-	0xc5, 0x13, 0xf0, 0x37, 0xb0, 0xa1, 0xaa, 0x13, 0x4b, 0x74, 0xed, 0xdc, 0xd2, 0x8b, 0x52, 0xc7,
-	0xb2, 0x4d, 0x24, 0xde, 0x82, 0xc7, 0x62, 0xec, 0xc8, 0x88, 0x92, 0x17, 0x41, 0x31, 0xa5, 0xea,
-	0x76, 0xf4, 0xdd, 0xef, 0xdc, 0xe1, 0x30, 0x45, 0xda, 0x28, 0x70, 0xae, 0x22, 0x03, 0x91, 0x6a,
-	0x1b, 0x94, 0x5d, 0xc5, 0x22, 0x7a, 0xb0, 0x61, 0x85, 0x5e, 0x35, 0x73, 0xf5, 0x9f, 0xa5, 0xf3,
-	0x75, 0xac, 0xf9, 0x94, 0xb4, 0x91, 0x87, 0x05, 0x79, 0x58, 0x90, 0xcd, 0x3c, 0x7f, 0x60, 0xec,
-	0xbe, 0x82, 0x10, 0x96, 0x1e, 0x0c, 0x72, 0xce, 0x8e, 0x1c, 0xc4, 0xf5, 0x24, 0x9b, 0x66, 0x57,
-	0x27, 0x8b, 0x94, 0x79, 0xce, 0xce, 0x34, 0x04, 0x2c, 0x4c, 0xaf, 0x15, 0x54, 0x4e, 0x86, 0xe9,
-	0x38, 0xee, 0x61, 0xaa, 0x3e, 0x95, 0xf9, 0x92, 0x8d, 0x9e, 0xc1, 0xc3, 0x26, 0xf0, 0x4b, 0x76,
-	0x1a, 0xd0, 0x96, 0x05, 0x5a, 0xd0, 0x15, 0x96, 0xe9, 0xd3, 0xf1, 0x62, 0xdc, 0xb3, 0xc7, 0x3f,
-	0xc4, 0x67, 0xec, 0xc2, 0xa3, 0x41, 0x6a, 0x70, 0x6f, 0x0d, 0x93, 0x75, 0xbe, 0xc3, 0x3b, 0xf1,
-	0xee, 0xf6, 0xab, 0x15, 0xd9, 0xb6, 0x15, 0xd9, 0x4f, 0x2b, 0xb2, 0xcf, 0x4e, 0x0c, 0xb6, 0x9d,
-	0x18, 0x7c, 0x77, 0x62, 0xf0, 0x32, 0x7b, 0xa5, 0xb8, 0x7e, 0xd7, 0xd2, 0xd4, 0x1b, 0xa5, 0x09,
-	0xec, 0x1b, 0x21, 0x50, 0x3f, 0xc6, 0xf5, 0x7e, 0x8c, 0xf8, 0xe1, 0x30, 0xe8, 0x51, 0xda, 0xe1,
-	0xe6, 0x37, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xc1, 0x44, 0x01, 0x3a, 0x01, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0x26, 0xa4, 0xc9, 0x84, 0x04, 0x58, 0x52, 0x6a, 0x55, 0xc2, 0x84, 0x5c, 0x9a,
+	0x0b, 0x36, 0x01, 0xc4, 0xbd, 0x49, 0x39, 0xe4, 0x16, 0x59, 0x3d, 0x21, 0x24, 0x6b, 0x6c, 0x4f,
+	0x93, 0xa5, 0xf6, 0xda, 0xf2, 0x6e, 0x43, 0xfa, 0x16, 0x48, 0xbc, 0x54, 0x8f, 0x3d, 0x72, 0x42,
+	0x28, 0x79, 0x0c, 0x2e, 0xc8, 0xeb, 0x38, 0x4a, 0x03, 0x12, 0xdc, 0xd6, 0xff, 0xff, 0x7f, 0x3b,
+	0xbb, 0xde, 0x19, 0x70, 0xb8, 0x1f, 0x38, 0x98, 0xa6, 0x11, 0x0f, 0x50, 0xf1, 0x44, 0x48, 0x47,
+	0x5c, 0x2a, 0x4f, 0x65, 0x28, 0xe4, 0x25, 0x65, 0xce, 0x62, 0xe8, 0x94, 0x6b, 0x3b, 0xcd, 0x12,
+	0x95, 0xb0, 0x1e, 0xf7, 0x03, 0x7b, 0x17, 0xb0, 0x77, 0x01, 0x7b, 0x31, 0x3c, 0xe9, 0xce, 0x92,
+	0x59, 0xa2, 0xc3, 0x4e, 0xbe, 0x2a, 0xb8, 0xfe, 0x39, 0xc0, 0x38, 0x42, 0x29, 0x2f, 0x32, 0x0c,
+	0x88, 0x31, 0xa8, 0xa5, 0xa8, 0xe6, 0xa6, 0xd1, 0x33, 0x06, 0x4d, 0x57, 0xaf, 0x59, 0x1f, 0xda,
+	0x3e, 0x4a, 0xf2, 0x82, 0x3c, 0xe6, 0xf1, 0xd0, 0x3c, 0xd0, 0x66, 0x2b, 0x17, 0x35, 0x3a, 0x09,
+	0xfb, 0xbf, 0xaa, 0x50, 0x9f, 0x62, 0x86, 0xb1, 0x64, 0x2f, 0xe1, 0xa1, 0x24, 0x11, 0x7a, 0x24,
+	0xd0, 0x8f, 0x28, 0xd4, 0x5b, 0x35, 0xdc, 0x56, 0xae, 0x7d, 0x28, 0x24, 0x76, 0x0a, 0x8f, 0x32,
+	0x0a, 0x88, 0x2f, 0x68, 0x9b, 0x3a, 0xd0, 0xa9, 0xce, 0x46, 0x2e, 0x83, 0x9f, 0xa0, 0x13, 0xcc,
+	0x51, 0x08, 0x8a, 0xbc, 0x54, 0xef, 0x6e, 0x56, 0x7b, 0xd5, 0x41, 0xeb, 0x8d, 0x63, 0xff, 0xeb,
+	0xb6, 0xf6, 0xb8, 0xe0, 0x8a, 0x43, 0x8d, 0x6a, 0xb7, 0x3f, 0x5e, 0x54, 0xdc, 0x76, 0xb0, 0x2b,
+	0xb2, 0xd7, 0xd0, 0xd5, 0x27, 0xc5, 0x28, 0x4a, 0xbe, 0x50, 0x58, 0x5c, 0x90, 0xa4, 0x59, 0xeb,
+	0x55, 0x07, 0x4d, 0x97, 0xe5, 0xde, 0x59, 0x61, 0x8d, 0x0b, 0x87, 0xd9, 0xf0, 0x54, 0x13, 0x21,
+	0x09, 0xbe, 0x03, 0x3c, 0xd0, 0xc0, 0x93, 0xdc, 0x3a, 0xd7, 0x4e, 0x99, 0x7f, 0x0f, 0xc7, 0xe5,
+	0x45, 0xf7, 0x8b, 0xd4, 0x35, 0x73, 0xb4, 0xb1, 0xf7, 0xea, 0xbc, 0x83, 0x67, 0x25, 0xb7, 0x57,
+	0xea, 0x50, 0x63, 0xdd, 0x8d, 0x7b, 0xbf, 0xda, 0x09, 0x34, 0x66, 0xd7, 0x98, 0x85, 0x1c, 0x85,
+	0xd9, 0xd0, 0x6f, 0xb4, 0xfd, 0x66, 0x43, 0x38, 0x8a, 0x71, 0xe9, 0xa9, 0xe4, 0x8a, 0x84, 0xf4,
+	0x52, 0xca, 0xbc, 0x14, 0x83, 0x2b, 0x52, 0x66, 0xb3, 0x67, 0x0c, 0x6a, 0x2e, 0x8b, 0x71, 0x79,
+	0xa1, 0xbd, 0x29, 0x65, 0x53, 0xed, 0xb0, 0x01, 0x3c, 0xce, 0x91, 0x22, 0xe7, 0xf9, 0x37, 0x8a,
+	0xa4, 0x09, 0x3a, 0xdd, 0x89, 0x71, 0x59, 0x84, 0x46, 0xb9, 0xda, 0xff, 0x66, 0x40, 0xfb, 0xde,
+	0xff, 0x66, 0xc7, 0x70, 0x98, 0x26, 0x99, 0xf2, 0x78, 0xf1, 0xfe, 0x4d, 0xb7, 0x9e, 0x7f, 0x4e,
+	0x42, 0xf6, 0x1c, 0xa0, 0x7c, 0xd1, 0x6d, 0x27, 0x35, 0x37, 0xca, 0x24, 0xfc, 0xa3, 0x79, 0xaa,
+	0xff, 0xd5, 0x3c, 0xb5, 0xbf, 0x35, 0xcf, 0xe8, 0xec, 0x76, 0x65, 0x19, 0x77, 0x2b, 0xcb, 0xf8,
+	0xb9, 0xb2, 0x8c, 0xaf, 0x6b, 0xab, 0x72, 0xb7, 0xb6, 0x2a, 0xdf, 0xd7, 0x56, 0xe5, 0xe3, 0xe9,
+	0x8c, 0xab, 0xf9, 0xb5, 0x6f, 0x07, 0x49, 0xec, 0xf8, 0x1c, 0xc5, 0x67, 0x4e, 0xc8, 0xf3, 0x01,
+	0x7b, 0xb5, 0x1d, 0x30, 0x75, 0x93, 0x92, 0xf4, 0xeb, 0x7a, 0x46, 0xde, 0xfe, 0x1e, 0x00, 0x84,
+	0x71, 0xc5, 0xf9, 0x8e, 0x03, 0x00, 0x00,
 }
 
 func (m *ClassTrace) Marshal() (dAtA []byte, err error) {
@@ -224,6 +401,73 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPacketBytes != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.MaxPacketBytes))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxTokensPerPacket != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.MaxTokensPerPacket))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ReceiveDeniedClasses) > 0 {
+		for iNdEx := len(m.ReceiveDeniedClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiveDeniedClasses[iNdEx])
+			copy(dAtA[i:], m.ReceiveDeniedClasses[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.ReceiveDeniedClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ReceiveAllowedClasses) > 0 {
+		for iNdEx := len(m.ReceiveAllowedClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiveAllowedClasses[iNdEx])
+			copy(dAtA[i:], m.ReceiveAllowedClasses[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.ReceiveAllowedClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SendDeniedClasses) > 0 {
+		for iNdEx := len(m.SendDeniedClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SendDeniedClasses[iNdEx])
+			copy(dAtA[i:], m.SendDeniedClasses[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.SendDeniedClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SendAllowedClasses) > 0 {
+		for iNdEx := len(m.SendAllowedClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SendAllowedClasses[iNdEx])
+			copy(dAtA[i:], m.SendAllowedClasses[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.SendAllowedClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChannelParams) > 0 {
+		for iNdEx := len(m.ChannelParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.ReceiveEnabled {
 		i--
 		if m.ReceiveEnabled {
@@ -247,6 +491,63 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChannelParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTransfer(v)
 	base := offset
@@ -287,6 +588,69 @@ func (m *Params) Size() (n int) {
 	if m.ReceiveEnabled {
 		n += 2
 	}
+	if len(m.ChannelParams) > 0 {
+		for _, e := range m.ChannelParams {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if len(m.SendAllowedClasses) > 0 {
+		for _, s := range m.SendAllowedClasses {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if len(m.SendDeniedClasses) > 0 {
+		for _, s := range m.SendDeniedClasses {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if len(m.ReceiveAllowedClasses) > 0 {
+		for _, s := range m.ReceiveAllowedClasses {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if len(m.ReceiveDeniedClasses) > 0 {
+		for _, s := range m.ReceiveDeniedClasses {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.MaxTokensPerPacket != 0 {
+		n += 1 + sovTransfer(uint64(m.MaxTokensPerPacket))
+	}
+	if m.MaxPacketBytes != 0 {
+		n += 1 + sovTransfer(uint64(m.MaxPacketBytes))
+	}
+	return n
+}
+
+func (m *ChannelParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -479,6 +843,392 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelParams = append(m.ChannelParams, ChannelParams{})
+			if err := m.ChannelParams[len(m.ChannelParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendAllowedClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendAllowedClasses = append(m.SendAllowedClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendDeniedClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendDeniedClasses = append(m.SendDeniedClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveAllowedClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiveAllowedClasses = append(m.ReceiveAllowedClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveDeniedClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiveDeniedClasses = append(m.ReceiveDeniedClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokensPerPacket", wireType)
+			}
+			m.MaxTokensPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokensPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketBytes", wireType)
+			}
+			m.MaxPacketBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])